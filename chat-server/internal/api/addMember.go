@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"errors"
 
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
 	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
 	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
 	"google.golang.org/grpc/codes"
@@ -26,6 +28,9 @@ func (s *Implementation) AddMember(ctx context.Context, req *desc.AddMemberReque
 
 	err = s.chatService.AddMember(ctx, req.ChatId, userId, req.Username)
 	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to add member: %v", err)
 	}
 
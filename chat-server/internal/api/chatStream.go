@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Implementation) ChatStream(stream desc.Chat_ChatStreamServer) error {
+	ctx := stream.Context()
+
+	// Достаем из токена username и uid пользователя
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	// Первым кадром клиент сообщает, к какому чату подключается
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	join := first.GetJoin()
+	if join == nil || join.ChatId <= 0 {
+		return status.Error(codes.InvalidArgument, "first frame must be join with chat id")
+	}
+	chatId := join.ChatId
+
+	// Подписываемся на сообщения чата, здесь же проверяется членство
+	msgChan, err := s.chatService.ConnectToChat(ctx, user.UID, user.Username, chatId)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "failed to connect to chat: %v", err)
+	}
+
+	// При отключении от чата отписываемся
+	defer s.chatService.DisconnectFromChat(chatId, user.UID)
+
+	// stream.Send нельзя вызывать из нескольких горутин, поэтому подтверждения отправляет основной цикл
+	acks := make(chan *desc.FrameAck, 16)
+	recvErr := make(chan error, 1)
+
+	// Читаем кадры клиента и обрабатываем их по порядку
+	go func() {
+		for {
+			frame, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			ack := s.handleClientFrame(ctx, user, chatId, frame)
+			if ack == nil {
+				continue
+			}
+
+			select {
+			case acks <- ack:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case msg, ok := <-msgChan:
+			if !ok {
+				logger.Debug("Message channel closed", zap.Int64("chat_id", chatId))
+				return nil
+			}
+
+			err := stream.Send(&desc.ChatServerFrame{Frame: &desc.ChatServerFrame_Message{Message: s.convertToProto(msg)}})
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return status.Errorf(codes.Internal, "failed to send message: %v", err)
+			}
+		case ack := <-acks:
+			err := stream.Send(&desc.ChatServerFrame{Frame: &desc.ChatServerFrame_Ack{Ack: ack}})
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return status.Errorf(codes.Internal, "failed to send ack: %v", err)
+			}
+		case err := <-recvErr:
+			// Клиент закрыл свою сторону стрима или отключился
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+				return nil
+			}
+			return err
+		case <-ctx.Done():
+			// Если клиент отключился
+			return nil
+		}
+	}
+}
+
+// handleClientFrame обрабатывает кадр клиента и возвращает подтверждение, nil - если подтверждение не нужно
+func (s *Implementation) handleClientFrame(ctx context.Context, user *utils.UserClaims, chatId int64, frame *desc.ChatClientFrame) *desc.FrameAck {
+	var err error
+
+	switch f := frame.Frame.(type) {
+	case *desc.ChatClientFrame_Send:
+		if f.Send == nil {
+			return newFrameAck(frame.CorrelationId, 0, status.Error(codes.InvalidArgument, "send frame is empty"))
+		}
+
+		// Сообщение всегда уходит в чат, к которому подключен стрим
+		f.Send.ChatId = chatId
+
+		sent, err := s.sendMessage(ctx, user, f.Send)
+		if err != nil {
+			logger.Warn("failed to send message from stream", zap.Int64("chat_id", chatId), zap.String("correlation_id", frame.CorrelationId), zap.Error(err))
+		}
+
+		ack := newFrameAck(frame.CorrelationId, sent.Id, err)
+		ack.MentionedUserIds = sent.Mentions
+		ack.Duplicate = sent.Duplicate
+		if err == nil {
+			ack.Seq = sent.Seq
+			ack.CreatedAt = timestamppb.New(sent.CreatedAt)
+		}
+
+		return ack
+	case *desc.ChatClientFrame_Typing:
+		if f.Typing == nil {
+			err = status.Error(codes.InvalidArgument, "typing frame is empty")
+			break
+		}
+
+		err = s.setTyping(ctx, user, chatId, f.Typing.IsTyping)
+	case *desc.ChatClientFrame_Join:
+		err = status.Error(codes.FailedPrecondition, "stream is already joined to a chat")
+	default:
+		err = status.Error(codes.InvalidArgument, "unknown frame")
+	}
+
+	if err != nil {
+		logger.Debug("failed to handle client frame", zap.Int64("chat_id", chatId), zap.String("correlation_id", frame.CorrelationId), zap.Error(err))
+	}
+
+	// Без correlation_id клиенту некуда сопоставить подтверждение
+	if frame.CorrelationId == "" {
+		return nil
+	}
+
+	return newFrameAck(frame.CorrelationId, 0, err)
+}
+
+// newFrameAck собирает подтверждение кадра, ошибка передается gRPC кодом и текстом
+func newFrameAck(correlationId string, messageId int64, err error) *desc.FrameAck {
+	ack := &desc.FrameAck{
+		CorrelationId: correlationId,
+		MessageId:     messageId,
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+		ack.Code = int32(st.Code())
+		ack.Error = st.Message()
+	}
+
+	return ack
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
 	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
@@ -41,6 +42,13 @@ func (s *Implementation) MyChats(ctx context.Context, req *desc.MyChatsRequest)
 			LastMessageSender: chat.LastMessageSender,
 			UnreadCount:       chat.UnreadCount,
 			MemberIds:         chat.MemberIds,
+			PinnedMessage:     convertPreviewToProto(chat.PinnedMessage),
+			MessageTtlSeconds: int32(chat.MessageTTL / time.Second),
+			MemberRoles:       make(map[int64]desc.MemberRole, len(chat.MemberRoles)),
+			JoinApproval:      chat.JoinApproval,
+		}
+		for userId, role := range chat.MemberRoles {
+			chatsInfo.MemberRoles[userId] = roleToProto(role)
 		}
 
 		if !chat.LastMessageAt.IsZero() {
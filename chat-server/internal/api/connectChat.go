@@ -62,6 +62,8 @@ func (s *Implementation) ConnectChat(req *desc.ConnectChatRequest, stream desc.C
 
 // convertToProto конвертирует MessageDTO в proto Message
 func (s *Implementation) convertToProto(msg service.MessageDTO) *desc.Message {
+	var res *desc.Message
+
 	// В зависимости от типа сообщения собираем стрктуру proto
 	switch msg.MessageType {
 	case service.MessageTypeOnlineUsers:
@@ -81,7 +83,7 @@ func (s *Implementation) convertToProto(msg service.MessageDTO) *desc.Message {
 		}
 	case service.MessageTypeVoice:
 		// Голосовое сообщение
-		return &desc.Message{
+		res = &desc.Message{
 			Type:          desc.MessageType_MESSAGE_TYPE_VOICE,
 			From:          msg.From,
 			Text:          msg.Text,
@@ -93,7 +95,7 @@ func (s *Implementation) convertToProto(msg service.MessageDTO) *desc.Message {
 		}
 	case service.MessageTypeImage:
 		// Изображение
-		return &desc.Message{
+		res = &desc.Message{
 			Type:      desc.MessageType_MESSAGE_TYPE_IMAGE,
 			From:      msg.From,
 			Text:      msg.Text,
@@ -104,7 +106,7 @@ func (s *Implementation) convertToProto(msg service.MessageDTO) *desc.Message {
 		}
 	case service.MessageTypeFile:
 		// Файл
-		return &desc.Message{
+		res = &desc.Message{
 			Type:      desc.MessageType_MESSAGE_TYPE_FILE,
 			From:      msg.From,
 			Text:      msg.Text,
@@ -113,9 +115,135 @@ func (s *Implementation) convertToProto(msg service.MessageDTO) *desc.Message {
 			FileName:  msg.FileName,
 			FileSize:  msg.FileSize,
 		}
+	case service.MessageTypePoll:
+		// Опрос, варианты и голоса добавляются ниже вместе с общими полями
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_POLL,
+			From:      msg.From,
+			Text:      msg.Text,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypePollUpdated:
+		// Событие опроса - клиенту нужны id и новые счетчики
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_POLL_UPDATED,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypeLinkPreview:
+		// Событие превью - клиенту нужны id сообщения и само превью
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_LINK_PREVIEW,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypeEdited:
+		// Событие редактирования - новый текст уже существующего сообщения
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_EDITED,
+			From:      msg.From,
+			Text:      msg.Text,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypeTyping:
+		// Индикатор набора
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_TYPING,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+		if msg.Typing != nil {
+			res.Typing = &desc.TypingEvent{
+				UserId:   msg.Typing.UserId,
+				Username: msg.Typing.Username,
+				IsTyping: msg.Typing.IsTyping,
+			}
+			if !msg.Typing.ExpiresAt.IsZero() {
+				res.Typing.ExpiresAt = timestamppb.New(msg.Typing.ExpiresAt)
+			}
+		}
+	case service.MessageTypeReadReceipt:
+		// Сдвиг указателя прочтения
+		res = &desc.Message{
+			Type:        desc.MessageType_MESSAGE_TYPE_READ_RECEIPT,
+			From:        msg.From,
+			CreatedAt:   timestamppb.New(msg.CreatedAt),
+			ReadReceipt: convertReadReceiptToProto(msg.ReadReceipt),
+		}
+	case service.MessageTypePin:
+		// Закрепление или открепление
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_PIN,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+		if msg.Pin != nil {
+			res.Pin = &desc.PinEvent{
+				UserId:   msg.Pin.UserId,
+				Username: msg.Pin.Username,
+				Pinned:   msg.Pin.Pinned,
+				Message:  convertPreviewToProto(msg.Pin.Message),
+			}
+		}
+	case service.MessageTypeReaction:
+		// Событие реакции
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_REACTION,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+		if msg.Reaction != nil {
+			res.Reaction = &desc.ReactionEvent{
+				Emoji:    msg.Reaction.Emoji,
+				UserId:   msg.Reaction.UserId,
+				Username: msg.Reaction.Username,
+				Added:    msg.Reaction.Added,
+				Count:    msg.Reaction.Count,
+			}
+		}
+	case service.MessageTypeThreadUpdated:
+		// Событие треда - клиенту нужны id корня и счетчики
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_THREAD_UPDATED,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypeExpired:
+		// Событие истечения - клиенту достаточно id
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_EXPIRED,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypeMemberLeft:
+		// Участник вышел - клиент убирает его из списка и обновляет владельца
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_MEMBER_LEFT,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+		if msg.MemberLeft != nil {
+			res.MemberLeft = &desc.MemberLeftEvent{
+				UserId:     msg.MemberLeft.UserId,
+				Username:   msg.MemberLeft.Username,
+				NewOwnerId: msg.MemberLeft.NewOwnerId,
+			}
+		}
+	case service.MessageTypeChatDeleted:
+		// Чат удален - после этого события стрим закрывается
+		return &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_CHAT_DELETED,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
+	case service.MessageTypeDeleted:
+		// Событие удаления - клиенту достаточно id
+		res = &desc.Message{
+			Type:      desc.MessageType_MESSAGE_TYPE_DELETED,
+			From:      msg.From,
+			CreatedAt: timestamppb.New(msg.CreatedAt),
+		}
 	default:
 		// Текстовое сообщение
-		return &desc.Message{
+		res = &desc.Message{
 			Type:      desc.MessageType_MESSAGE_TYPE_TEXT,
 			From:      msg.From,
 			Text:      msg.Text,
@@ -123,4 +251,62 @@ func (s *Implementation) convertToProto(msg service.MessageDTO) *desc.Message {
 		}
 	}
 
+	// Общие поля для всех сообщений из БД
+	res.Id = msg.Id
+	res.Seq = msg.Seq
+	res.Deleted = msg.Deleted
+	res.ThreadRootId = msg.ThreadRootId
+	res.ThreadReplyCount = msg.ThreadReplyCount
+	if !msg.ThreadLastReplyAt.IsZero() {
+		res.ThreadLastReplyAt = timestamppb.New(msg.ThreadLastReplyAt)
+	}
+	for _, r := range msg.Reactions {
+		res.Reactions = append(res.Reactions, &desc.ReactionSummary{
+			Emoji:       r.Emoji,
+			Count:       r.Count,
+			ReactedByMe: r.ReactedByMe,
+		})
+	}
+	res.ReplyTo = convertPreviewToProto(msg.ReplyTo)
+	res.MentionedUserIds = msg.Mentions
+	res.Poll = convertPollToProto(msg.Poll)
+	if msg.LinkPreview != nil {
+		res.LinkPreview = &desc.LinkPreview{
+			Url:         msg.LinkPreview.Url,
+			Title:       msg.LinkPreview.Title,
+			Description: msg.LinkPreview.Description,
+			ImageUrl:    msg.LinkPreview.ImageUrl,
+			SiteName:    msg.LinkPreview.SiteName,
+		}
+	}
+	if msg.ForwardedFrom != nil {
+		res.ForwardedFrom = &desc.ForwardedFrom{
+			MessageId: msg.ForwardedFrom.MessageId,
+			ChatId:    msg.ForwardedFrom.ChatId,
+			From:      msg.ForwardedFrom.From,
+		}
+	}
+	if !msg.EditedAt.IsZero() {
+		res.EditedAt = timestamppb.New(msg.EditedAt)
+	}
+	if !msg.ExpiresAt.IsZero() {
+		res.ExpiresAt = timestamppb.New(msg.ExpiresAt)
+	}
+
+	return res
+}
+
+// convertPreviewToProto конвертирует превью сообщения в proto
+func convertPreviewToProto(preview *service.ReplyPreviewDTO) *desc.ReplyPreview {
+	if preview == nil {
+		return nil
+	}
+
+	return &desc.ReplyPreview{
+		MessageId: preview.MessageId,
+		From:      preview.From,
+		Text:      preview.Text,
+		Type:      desc.MessageType(preview.MessageType),
+		Deleted:   preview.Deleted,
+	}
 }
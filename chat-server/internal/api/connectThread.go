@@ -0,0 +1,55 @@
+package api
+
+import (
+	"errors"
+	"io"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) ConnectThread(req *desc.ConnectThreadRequest, stream desc.Chat_ConnectThreadServer) error {
+	if req.ThreadRootId <= 0 {
+		return status.Error(codes.InvalidArgument, "thread root id is required")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	msgChan, err := s.chatService.ConnectToThread(stream.Context(), user.UID, user.Username, req.ThreadRootId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Errorf(codes.NotFound, "failed to connect to thread: %v", err)
+	}
+
+	defer s.chatService.DisconnectFromThread(req.ThreadRootId, user.UID)
+
+	for {
+		select {
+		case msg, ok := <-msgChan:
+			if !ok {
+				logger.Debug("Thread channel closed", zap.Int64("thread_root_id", req.ThreadRootId))
+				return nil
+			}
+
+			if err := stream.Send(s.convertToProto(msg)); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+
+				return status.Errorf(codes.Internal, "failed to send message: %v", err)
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
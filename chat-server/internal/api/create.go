@@ -18,6 +18,9 @@ func (s *Implementation) Create(ctx context.Context, req *desc.CreateRequest) (*
 	if len(req.Usernames) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "usernames is required")
 	}
+	if req.JoinApproval && !req.IsPublic {
+		return nil, status.Error(codes.InvalidArgument, "join_approval requires a public chat")
+	}
 
 	// Достаем из токена username и uid пользователя
 	user, err := utils.GetUserClaimsFromContext(ctx)
@@ -27,7 +30,7 @@ func (s *Implementation) Create(ctx context.Context, req *desc.CreateRequest) (*
 	logger.Debug("attempt to create chat", zap.String("creator", user.Username), zap.Strings("inviting", req.Usernames))
 
 	// Передаем создателя отдельно от приглашенных
-	id, err := s.chatService.Create(ctx, req.Name, req.IsPublic, user.UID, user.Username, req.Usernames)
+	id, err := s.chatService.Create(ctx, req.Name, req.IsPublic, req.JoinApproval, user.UID, user.Username, req.Usernames)
 	if err != nil {
 		// Проверяем типизированную ошибку
 		var usersNotFound *service.ErrUserNotFound
@@ -2,23 +2,68 @@ package api
 
 import (
 	"context"
+	"errors"
 
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
 	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *Implementation) Delete(ctx context.Context, req *desc.DeleteRequest) (*emptypb.Empty, error) {
-	if req.Id == 0 {
+func (s *Implementation) Delete(ctx context.Context, req *desc.DeleteRequest) (*desc.DeleteResponse, error) {
+	if req.Id <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "chat id is required")
 	}
 
-	err := s.chatService.Delete(ctx, req.Id)
+	userId, err := utils.GetUIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete chat: %v", err)
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	restoreUntil, err := s.chatService.Delete(ctx, userId, req.Id)
+	if err != nil {
+		return nil, chatDeleteError(err, "failed to delete chat")
+	}
+
+	res := &desc.DeleteResponse{}
+	if !restoreUntil.IsZero() {
+		res.RestoreUntil = timestamppb.New(restoreUntil)
+	}
+
+	return res, nil
+}
+
+func (s *Implementation) RestoreChat(ctx context.Context, req *desc.RestoreChatRequest) (*emptypb.Empty, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.RestoreChat(ctx, userId, req.ChatId)
+	if err != nil {
+		return nil, chatDeleteError(err, "failed to restore chat")
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// chatDeleteError переводит ошибку удаления или восстановления чата в gRPC статус
+func chatDeleteError(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrChatNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrChatNotRestorable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
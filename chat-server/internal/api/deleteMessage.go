@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Implementation) DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "message id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.DeleteMessage(ctx, userId, req.MessageId, req.ForEveryone)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete message: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
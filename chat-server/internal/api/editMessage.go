@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Implementation) EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*emptypb.Empty, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "message id is required")
+	}
+
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, status.Error(codes.InvalidArgument, "text cannot be empty")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.EditMessage(ctx, userId, req.MessageId, req.Text)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, service.ErrEditWindowExpired):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to edit message: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxForwardMessages = 100
+	maxForwardTargets  = 10
+)
+
+func (s *Implementation) ForwardMessages(ctx context.Context, req *desc.ForwardMessagesRequest) (*desc.ForwardMessagesResponse, error) {
+	if req.SourceChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "source chat id is required")
+	}
+
+	messageIds := uniquePositiveIds(req.MessageIds)
+	if len(messageIds) == 0 || len(messageIds) > maxForwardMessages {
+		return nil, status.Errorf(codes.InvalidArgument, "from 1 to %d message ids are required", maxForwardMessages)
+	}
+
+	targetChatIds := uniquePositiveIds(req.TargetChatIds)
+	if len(targetChatIds) == 0 || len(targetChatIds) > maxForwardTargets {
+		return nil, status.Errorf(codes.InvalidArgument, "from 1 to %d target chat ids are required", maxForwardTargets)
+	}
+
+	if req.ClientKey != "" {
+		if err := uuid.Validate(req.ClientKey); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "client_key must be a UUID: %v", err)
+		}
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	forwarded, err := s.chatService.ForwardMessages(ctx, user.UID, user.Username, req.SourceChatId, messageIds, targetChatIds, req.ClientKey)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to forward messages: %v", err)
+	}
+
+	res := make([]*desc.ForwardedMessage, 0, len(forwarded))
+	for _, f := range forwarded {
+		res = append(res, &desc.ForwardedMessage{
+			ChatId:    f.ChatId,
+			Message:   s.convertToProto(f.Message),
+			Duplicate: f.Message.Duplicate,
+		})
+	}
+
+	return &desc.ForwardMessagesResponse{Messages: res}, nil
+}
+
+// uniquePositiveIds убирает повторы и невалидные id, сохраняя порядок
+func uniquePositiveIds(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	return res
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) GetHistory(ctx context.Context, req *desc.GetHistoryRequest) (*desc.GetHistoryResponse, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	cursors := 0
+	for _, id := range []int64{req.BeforeId, req.AfterId, req.AroundId} {
+		if id > 0 {
+			cursors++
+		}
+	}
+	if cursors > 1 {
+		return nil, status.Error(codes.InvalidArgument, "only one of before_id, after_id and around_id can be set")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	if req.AroundId > 0 {
+		return s.historyAround(ctx, userId, req)
+	}
+
+	messages, hasMore, err := s.chatService.GetHistory(ctx, userId, req.ChatId, req.BeforeId, req.AfterId, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get history: %v", err)
+	}
+
+	res := make([]*desc.Message, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, s.convertToProto(msg))
+	}
+
+	return &desc.GetHistoryResponse{
+		Messages: res,
+		HasMore:  hasMore,
+	}, nil
+}
+
+// historyAround загружает страницу вокруг сообщения req.AroundId
+func (s *Implementation) historyAround(ctx context.Context, userId int64, req *desc.GetHistoryRequest) (*desc.GetHistoryResponse, error) {
+	messages, hasOlder, hasNewer, err := s.chatService.GetHistoryAround(ctx, userId, req.ChatId, req.AroundId, int(req.Limit))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get history: %v", err)
+	}
+
+	res := make([]*desc.Message, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, s.convertToProto(msg))
+	}
+
+	return &desc.GetHistoryResponse{
+		Messages: res,
+		HasMore:  hasOlder,
+		HasNewer: hasNewer,
+	}, nil
+}
+
+// maxSeqRange сколько номеров можно догрузить за один запрос, как и страница истории
+const maxSeqRange = 100
+
+func (s *Implementation) GetMessagesBySeq(ctx context.Context, req *desc.GetMessagesBySeqRequest) (*desc.GetMessagesBySeqResponse, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+	if req.FromSeq <= 0 || req.ToSeq < req.FromSeq {
+		return nil, status.Error(codes.InvalidArgument, "from_seq must be positive and not greater than to_seq")
+	}
+	if req.ToSeq-req.FromSeq >= maxSeqRange {
+		return nil, status.Errorf(codes.InvalidArgument, "range cannot be longer than %d messages", maxSeqRange)
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	messages, err := s.chatService.GetMessagesBySeq(ctx, userId, req.ChatId, req.FromSeq, req.ToSeq)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get messages by seq: %v", err)
+	}
+
+	res := make([]*desc.Message, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, s.convertToProto(msg))
+	}
+
+	return &desc.GetMessagesBySeqResponse{Messages: res}, nil
+}
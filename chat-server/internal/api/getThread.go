@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Implementation) GetThread(ctx context.Context, req *desc.GetThreadRequest) (*desc.GetThreadResponse, error) {
+	if req.ThreadRootId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "thread root id is required")
+	}
+
+	if req.BeforeId > 0 && req.AfterId > 0 {
+		return nil, status.Error(codes.InvalidArgument, "only one of before_id and after_id can be set")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	root, replies, hasMore, err := s.chatService.GetThread(ctx, userId, req.ThreadRootId, req.BeforeId, req.AfterId, int(req.Limit))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get thread: %v", err)
+	}
+
+	res := make([]*desc.Message, 0, len(replies))
+	for _, msg := range replies {
+		res = append(res, s.convertToProto(msg))
+	}
+
+	return &desc.GetThreadResponse{
+		Root:    s.convertToProto(root),
+		Replies: res,
+		HasMore: hasMore,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxInviteCodeLen с запасом больше длины сгенерированного кода, длинные строки не хешируем
+const maxInviteCodeLen = 64
+
+func (s *Implementation) CreateInvite(ctx context.Context, req *desc.CreateInviteRequest) (*desc.Invite, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+	if req.MaxUses < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_uses must not be negative")
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+		if !expiresAt.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	invite, err := s.chatService.CreateInvite(ctx, userId, req.ChatId, req.MaxUses, expiresAt)
+	if err != nil {
+		return nil, inviteError(err, "failed to create invite")
+	}
+
+	return convertInviteToProto(invite), nil
+}
+
+func (s *Implementation) RevokeInvite(ctx context.Context, req *desc.RevokeInviteRequest) (*emptypb.Empty, error) {
+	if req.InviteId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invite id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.RevokeInvite(ctx, userId, req.InviteId)
+	if err != nil {
+		return nil, inviteError(err, "failed to revoke invite")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Implementation) ListInvites(ctx context.Context, req *desc.ListInvitesRequest) (*desc.ListInvitesResponse, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	invites, err := s.chatService.ListInvites(ctx, userId, req.ChatId)
+	if err != nil {
+		return nil, inviteError(err, "failed to list invites")
+	}
+
+	res := &desc.ListInvitesResponse{Invites: make([]*desc.Invite, 0, len(invites))}
+	for _, invite := range invites {
+		res.Invites = append(res.Invites, convertInviteToProto(invite))
+	}
+
+	return res, nil
+}
+
+func (s *Implementation) GetInvitePreview(ctx context.Context, req *desc.GetInvitePreviewRequest) (*desc.InvitePreview, error) {
+	if req.Code == "" || len(req.Code) > maxInviteCodeLen {
+		return nil, status.Error(codes.InvalidArgument, "invalid invite code")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	preview, err := s.chatService.InvitePreview(ctx, userId, req.Code)
+	if err != nil {
+		return nil, inviteError(err, "failed to get invite preview")
+	}
+
+	return &desc.InvitePreview{
+		ChatId:      preview.ChatId,
+		Name:        preview.Name,
+		MemberCount: preview.MemberCount,
+		IsMember:    preview.IsMember,
+	}, nil
+}
+
+func (s *Implementation) JoinByInvite(ctx context.Context, req *desc.JoinByInviteRequest) (*desc.JoinByInviteResponse, error) {
+	if req.Code == "" || len(req.Code) > maxInviteCodeLen {
+		return nil, status.Error(codes.InvalidArgument, "invalid invite code")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	}
+
+	chatId, alreadyMember, err := s.chatService.JoinByInvite(ctx, user.UID, user.Username, req.Code)
+	if err != nil {
+		return nil, inviteError(err, "failed to join by invite")
+	}
+
+	return &desc.JoinByInviteResponse{
+		ChatId:        chatId,
+		AlreadyMember: alreadyMember,
+	}, nil
+}
+
+// inviteError переводит ошибку приглашений в gRPC статус
+func inviteError(err error, msg string) error {
+	if errors.Is(err, service.ErrInviteNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return memberError(err, msg)
+}
+
+func convertInviteToProto(invite service.InviteDTO) *desc.Invite {
+	res := &desc.Invite{
+		Id:        invite.Id,
+		ChatId:    invite.ChatId,
+		Code:      invite.Code,
+		CodeHint:  invite.CodeHint,
+		CreatedBy: invite.CreatedBy,
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		CreatedAt: timestamppb.New(invite.CreatedAt),
+		Revoked:   invite.Revoked,
+	}
+	if !invite.ExpiresAt.IsZero() {
+		res.ExpiresAt = timestamppb.New(invite.ExpiresAt)
+	}
+
+	return res
+}
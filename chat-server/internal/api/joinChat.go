@@ -7,10 +7,9 @@ import (
 	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func (s *Implementation) JoinChat(ctx context.Context, req *desc.JoinChatRequest) (*emptypb.Empty, error) {
+func (s *Implementation) JoinChat(ctx context.Context, req *desc.JoinChatRequest) (*desc.JoinChatResponse, error) {
 	user, err := utils.GetUserClaimsFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "authentication required")
@@ -20,10 +19,10 @@ func (s *Implementation) JoinChat(ctx context.Context, req *desc.JoinChatRequest
 		return nil, status.Error(codes.InvalidArgument, "chat id is required")
 	}
 
-	err = s.chatService.JoinChat(ctx, req.ChatId, user.UID, user.Username)
+	pending, err := s.chatService.JoinChat(ctx, req.ChatId, user.UID, user.Username)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to join chat: %v", err)
 	}
 
-	return &emptypb.Empty{}, nil
+	return &desc.JoinChatResponse{Pending: pending}, nil
 }
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Implementation) ListJoinRequests(ctx context.Context, req *desc.ListJoinRequestsRequest) (*desc.ListJoinRequestsResponse, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	requests, err := s.chatService.ListJoinRequests(ctx, userId, req.ChatId)
+	if err != nil {
+		return nil, joinRequestError(err, "failed to list join requests")
+	}
+
+	res := &desc.ListJoinRequestsResponse{Requests: make([]*desc.JoinRequest, 0, len(requests))}
+	for _, request := range requests {
+		res.Requests = append(res.Requests, &desc.JoinRequest{
+			Id:        request.Id,
+			ChatId:    request.ChatId,
+			UserId:    request.UserId,
+			Username:  request.Username,
+			CreatedAt: timestamppb.New(request.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (s *Implementation) ApproveJoinRequest(ctx context.Context, req *desc.ResolveJoinRequestRequest) (*desc.JoinRequestDecision, error) {
+	if req.RequestId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "request id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	decision, err := s.chatService.ApproveJoinRequest(ctx, userId, req.RequestId)
+	if err != nil {
+		return nil, joinRequestError(err, "failed to approve join request")
+	}
+
+	return convertDecisionToProto(decision), nil
+}
+
+func (s *Implementation) RejectJoinRequest(ctx context.Context, req *desc.ResolveJoinRequestRequest) (*desc.JoinRequestDecision, error) {
+	if req.RequestId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "request id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	decision, err := s.chatService.RejectJoinRequest(ctx, userId, req.RequestId)
+	if err != nil {
+		return nil, joinRequestError(err, "failed to reject join request")
+	}
+
+	return convertDecisionToProto(decision), nil
+}
+
+// joinRequestError переводит ошибку заявок на вступление в gRPC статус
+func joinRequestError(err error, msg string) error {
+	if errors.Is(err, service.ErrJoinRequestNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return memberError(err, msg)
+}
+
+func convertDecisionToProto(decision service.JoinRequestDecisionDTO) *desc.JoinRequestDecision {
+	return &desc.JoinRequestDecision{
+		RequestId: decision.RequestId,
+		ChatId:    decision.ChatId,
+		ChatName:  decision.ChatName,
+		UserId:    decision.UserId,
+		Approved:  decision.Approved,
+	}
+}
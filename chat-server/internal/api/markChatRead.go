@@ -2,12 +2,15 @@ package api
 
 import (
 	"context"
+	"errors"
 
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
 	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
 	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Implementation) MarkChatRead(ctx context.Context, req *desc.MarkChatReadRequest) (*emptypb.Empty, error) {
@@ -15,15 +18,65 @@ func (s *Implementation) MarkChatRead(ctx context.Context, req *desc.MarkChatRea
 		return nil, status.Error(codes.InvalidArgument, "chat id is required")
 	}
 
-	userId, err := utils.GetUIDFromContext(ctx)
+	if req.MessageId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "message id must not be negative")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "authentication is required")
 	}
 
-	err = s.chatService.MarkChatRead(ctx, req.ChatId, userId)
+	err = s.chatService.MarkChatRead(ctx, req.ChatId, user.UID, user.Username, req.MessageId)
 	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to mark chat as read: %v", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Implementation) GetReadReceipts(ctx context.Context, req *desc.GetReadReceiptsRequest) (*desc.GetReadReceiptsResponse, error) {
+	if req.ChatId <= 0 || req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id and message id are required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	receipts, err := s.chatService.GetReadReceipts(ctx, userId, req.ChatId, req.MessageId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get read receipts: %v", err)
+	}
+
+	res := make([]*desc.ReadReceipt, 0, len(receipts))
+	for _, receipt := range receipts {
+		res = append(res, convertReadReceiptToProto(&receipt))
+	}
+
+	return &desc.GetReadReceiptsResponse{Receipts: res}, nil
+}
+
+// convertReadReceiptToProto конвертирует отметку прочтения в proto
+func convertReadReceiptToProto(receipt *service.ReadReceiptDTO) *desc.ReadReceipt {
+	if receipt == nil {
+		return nil
+	}
+
+	return &desc.ReadReceipt{
+		UserId:            receipt.UserId,
+		Username:          receipt.Username,
+		LastReadMessageId: receipt.LastReadMessageId,
+		ReadAt:            timestamppb.New(receipt.ReadAt),
+	}
+}
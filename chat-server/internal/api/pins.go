@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Implementation) PinMessage(ctx context.Context, req *desc.PinMessageRequest) (*emptypb.Empty, error) {
+	return s.changePin(ctx, req, s.chatService.PinMessage)
+}
+
+func (s *Implementation) UnpinMessage(ctx context.Context, req *desc.PinMessageRequest) (*emptypb.Empty, error) {
+	return s.changePin(ctx, req, s.chatService.UnpinMessage)
+}
+
+// changePin общая часть закрепления и открепления
+func (s *Implementation) changePin(ctx context.Context, req *desc.PinMessageRequest, change func(ctx context.Context, userId int64, username string, chatId int64, messageId int64) error) (*emptypb.Empty, error) {
+	if req.ChatId <= 0 || req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id and message id are required")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	err = change(ctx, user.UID, user.Username, req.ChatId, req.MessageId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to change pin: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Implementation) ListPinned(ctx context.Context, req *desc.ListPinnedRequest) (*desc.ListPinnedResponse, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	pins, err := s.chatService.ListPinned(ctx, userId, req.ChatId)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list pinned messages: %v", err)
+	}
+
+	res := make([]*desc.PinnedMessage, 0, len(pins))
+	for _, pin := range pins {
+		res = append(res, &desc.PinnedMessage{
+			Message:          s.convertToProto(pin.Message),
+			PinnedBy:         pin.PinnedBy,
+			PinnedByUsername: pin.PinnedByUsername,
+			PinnedAt:         timestamppb.New(pin.PinnedAt),
+		})
+	}
+
+	return &desc.ListPinnedResponse{Pins: res}, nil
+}
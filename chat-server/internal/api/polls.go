@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Implementation) VotePoll(ctx context.Context, req *desc.VotePollRequest) (*desc.VotePollResponse, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "message id is required")
+	}
+
+	optionIds := uniquePositiveIds(req.OptionIds)
+	if len(optionIds) != len(req.OptionIds) {
+		return nil, status.Error(codes.InvalidArgument, "option ids must be unique and positive")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	poll, err := s.chatService.VotePoll(ctx, user.UID, req.MessageId, optionIds)
+	if err != nil {
+		return nil, pollError(err, "failed to vote poll")
+	}
+
+	return &desc.VotePollResponse{Poll: convertPollToProto(&poll)}, nil
+}
+
+func (s *Implementation) ClosePoll(ctx context.Context, req *desc.ClosePollRequest) (*emptypb.Empty, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "message id is required")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	err = s.chatService.ClosePoll(ctx, user.UID, req.MessageId)
+	if err != nil {
+		return nil, pollError(err, "failed to close poll")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// pollError переводит ошибку сервиса опросов в gRPC статус
+func pollError(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrMessageNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrPollClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrInvalidPollVote):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// convertPollToProto конвертирует опрос в proto
+func convertPollToProto(poll *service.PollDTO) *desc.Poll {
+	if poll == nil {
+		return nil
+	}
+
+	res := &desc.Poll{
+		MultipleChoice: poll.MultipleChoice,
+		Anonymous:      poll.Anonymous,
+		Closed:         poll.Closed,
+		TotalVoters:    poll.TotalVoters,
+	}
+	if !poll.ClosesAt.IsZero() {
+		res.ClosesAt = timestamppb.New(poll.ClosesAt)
+	}
+	for _, option := range poll.Options {
+		res.Options = append(res.Options, &desc.PollOption{
+			Id:        option.Id,
+			Text:      option.Text,
+			Votes:     option.Votes,
+			VotedByMe: option.VotedByMe,
+			VoterIds:  option.VoterIds,
+		})
+	}
+
+	return res
+}
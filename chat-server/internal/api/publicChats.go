@@ -23,6 +23,7 @@ func (s *Implementation) PublicChats(ctx context.Context, req *desc.PublicChatsR
 			MembersCount: int32(chat.MemberCount),
 			CreatorName:  chat.CreatorName,
 			CreatedAt:    timestamppb.New(chat.CreatedAt),
+			JoinApproval: chat.JoinApproval,
 		})
 	}
 
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxEmojiLen ограничение колонки message_reactions.emoji, составные эмодзи занимают несколько символов
+const maxEmojiLen = 32
+
+func (s *Implementation) AddReaction(ctx context.Context, req *desc.ReactionRequest) (*emptypb.Empty, error) {
+	return s.changeReaction(ctx, req, true)
+}
+
+func (s *Implementation) RemoveReaction(ctx context.Context, req *desc.ReactionRequest) (*emptypb.Empty, error) {
+	return s.changeReaction(ctx, req, false)
+}
+
+func (s *Implementation) changeReaction(ctx context.Context, req *desc.ReactionRequest, add bool) (*emptypb.Empty, error) {
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "message id is required")
+	}
+
+	if err := validateEmoji(req.Emoji); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	if add {
+		err = s.chatService.AddReaction(ctx, user.UID, user.Username, req.MessageId, req.Emoji)
+	} else {
+		err = s.chatService.RemoveReaction(ctx, user.UID, user.Username, req.MessageId, req.Emoji)
+	}
+	if err != nil {
+		if errors.Is(err, service.ErrMessageNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to change reaction: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateEmoji(emoji string) error {
+	if emoji == "" {
+		return errors.New("emoji cannot be empty")
+	}
+	if !utf8.ValidString(emoji) || utf8.RuneCountInString(emoji) > maxEmojiLen {
+		return errors.New("invalid emoji")
+	}
+	if strings.ContainsAny(emoji, " \t\r\n") {
+		return errors.New("emoji cannot contain spaces")
+	}
+	return nil
+}
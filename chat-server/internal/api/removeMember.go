@@ -26,7 +26,7 @@ func (s *Implementation) RemoveMember(ctx context.Context, req *desc.RemoveMembe
 
 	err = s.chatService.RemoveMember(ctx, req.ChatId, userId, req.UserId, req.Username)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to remove member: %v", err)
+		return nil, memberError(err, "failed to remove member")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Implementation) SetMemberRole(ctx context.Context, req *desc.SetMemberRoleRequest) (*emptypb.Empty, error) {
+	if req.ChatId <= 0 || req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat_id and user_id are required")
+	}
+
+	role := roleFromProto(req.Role)
+	if role == "" || role == service.RoleOwner {
+		return nil, status.Error(codes.InvalidArgument, "role must be admin, moderator, member or read_only, use TransferOwnership to change owner")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.SetMemberRole(ctx, userId, req.ChatId, req.UserId, role)
+	if err != nil {
+		return nil, memberError(err, "failed to set member role")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Implementation) TransferOwnership(ctx context.Context, req *desc.TransferOwnershipRequest) (*emptypb.Empty, error) {
+	if req.ChatId <= 0 || req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat_id and user_id are required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.TransferOwnership(ctx, userId, req.ChatId, req.UserId)
+	if err != nil {
+		return nil, memberError(err, "failed to transfer ownership")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// memberError переводит ошибку управления участниками в gRPC статус
+func memberError(err error, msg string) error {
+	switch {
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrMemberNotFound), errors.Is(err, service.ErrChatNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrDirectChat):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// roleFromProto конвертирует роль из proto в роль сервиса, пустая строка для неизвестной роли
+func roleFromProto(role desc.MemberRole) string {
+	switch role {
+	case desc.MemberRole_MEMBER_ROLE_OWNER:
+		return service.RoleOwner
+	case desc.MemberRole_MEMBER_ROLE_ADMIN:
+		return service.RoleAdmin
+	case desc.MemberRole_MEMBER_ROLE_MODERATOR:
+		return service.RoleModerator
+	case desc.MemberRole_MEMBER_ROLE_MEMBER:
+		return service.RoleMember
+	case desc.MemberRole_MEMBER_ROLE_READ_ONLY:
+		return service.RoleReadOnly
+	}
+	return ""
+}
+
+// roleToProto конвертирует роль сервиса в proto
+func roleToProto(role string) desc.MemberRole {
+	switch role {
+	case service.RoleOwner:
+		return desc.MemberRole_MEMBER_ROLE_OWNER
+	case service.RoleAdmin:
+		return desc.MemberRole_MEMBER_ROLE_ADMIN
+	case service.RoleModerator:
+		return desc.MemberRole_MEMBER_ROLE_MODERATOR
+	case service.RoleMember:
+		return desc.MemberRole_MEMBER_ROLE_MEMBER
+	case service.RoleReadOnly:
+		return desc.MemberRole_MEMBER_ROLE_READ_ONLY
+	}
+	return desc.MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxScheduleAhead насколько далеко вперед можно отложить сообщение
+const maxScheduleAhead = 365 * 24 * time.Hour
+
+func (s *Implementation) ScheduleMessage(ctx context.Context, req *desc.ScheduleMessageRequest) (*desc.ScheduledMessage, error) {
+	if req.Message == nil {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
+	}
+	if err := validateMessage(req.Message); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Варианты опроса получают id только при отправке, откладывать опросы не поддерживаем
+	if req.Message.Type == desc.MessageType_MESSAGE_TYPE_POLL {
+		return nil, status.Error(codes.InvalidArgument, "polls cannot be scheduled")
+	}
+
+	if req.SendAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "send_at is required")
+	}
+	sendAt := req.SendAt.AsTime()
+	now := time.Now()
+	if !sendAt.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "send_at must be in the future")
+	}
+	if sendAt.Sub(now) > maxScheduleAhead {
+		return nil, status.Error(codes.InvalidArgument, "send_at is too far in the future")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	msg := service.SendMessageDTO{
+		ChatId:        req.Message.ChatId,
+		UserId:        user.UID,
+		FromUsername:  user.Username,
+		Text:          req.Message.Text,
+		MessageType:   int32(req.Message.Type),
+		VoiceDuration: req.Message.VoiceDuration,
+		FileUrl:       req.Message.FileUrl,
+		FileName:      req.Message.FileName,
+		FileSize:      req.Message.FileSize,
+		ReplyToId:     req.Message.ReplyToMessageId,
+		ThreadRootId:  req.Message.ThreadRootId,
+	}
+
+	scheduled, err := s.chatService.ScheduleMessage(ctx, msg, sendAt)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to schedule message: %v", err)
+	}
+
+	return convertScheduledToProto(scheduled), nil
+}
+
+func (s *Implementation) ListScheduled(ctx context.Context, req *desc.ListScheduledRequest) (*desc.ListScheduledResponse, error) {
+	if req.ChatId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid chat id")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	messages, err := s.chatService.ListScheduled(ctx, userId, req.ChatId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled messages: %v", err)
+	}
+
+	res := make([]*desc.ScheduledMessage, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, convertScheduledToProto(msg))
+	}
+
+	return &desc.ListScheduledResponse{Messages: res}, nil
+}
+
+func (s *Implementation) CancelScheduled(ctx context.Context, req *desc.CancelScheduledRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	err = s.chatService.CancelScheduled(ctx, userId, req.Id)
+	if err != nil {
+		if errors.Is(err, service.ErrMessageNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled message: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// convertScheduledToProto конвертирует отложенное сообщение в proto
+func convertScheduledToProto(msg service.ScheduledMessageDTO) *desc.ScheduledMessage {
+	return &desc.ScheduledMessage{
+		Id:               msg.Id,
+		ChatId:           msg.ChatId,
+		Type:             desc.MessageType(msg.MessageType),
+		Text:             msg.Text,
+		VoiceDuration:    msg.VoiceDuration,
+		FileUrl:          msg.FileUrl,
+		FileName:         msg.FileName,
+		FileSize:         msg.FileSize,
+		ReplyToMessageId: msg.ReplyToId,
+		ThreadRootId:     msg.ThreadRootId,
+		SendAt:           timestamppb.New(msg.SendAt),
+		CreatedAt:        timestamppb.New(msg.CreatedAt),
+		Attempts:         msg.Attempts,
+		LastError:        msg.LastError,
+		Failed:           msg.Failed,
+	}
+}
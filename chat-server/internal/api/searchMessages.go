@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minSearchQueryLen = 2
+	maxSearchQueryLen = 200
+)
+
+func (s *Implementation) SearchMessages(ctx context.Context, req *desc.SearchMessagesRequest) (*desc.SearchMessagesResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if n := utf8.RuneCountInString(query); n < minSearchQueryLen || n > maxSearchQueryLen {
+		return nil, status.Errorf(codes.InvalidArgument, "query must be from %d to %d characters", minSearchQueryLen, maxSearchQueryLen)
+	}
+
+	if req.DateFrom != nil && req.DateTo != nil && !req.DateFrom.AsTime().Before(req.DateTo.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "date_from must be before date_to")
+	}
+
+	userId, err := utils.GetUIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+
+	filter := service.SearchMessagesDTO{
+		Query:        query,
+		ChatId:       req.ChatId,
+		FromUsername: strings.TrimSpace(req.FromUsername),
+		BeforeId:     req.BeforeId,
+		Limit:        int(req.Limit),
+	}
+	if req.Type != nil {
+		msgType := int32(*req.Type)
+		filter.MessageType = &msgType
+	}
+	if req.DateFrom != nil {
+		dateFrom := req.DateFrom.AsTime()
+		filter.DateFrom = &dateFrom
+	}
+	if req.DateTo != nil {
+		dateTo := req.DateTo.AsTime()
+		filter.DateTo = &dateTo
+	}
+
+	results, hasMore, err := s.chatService.SearchMessages(ctx, userId, filter)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to search messages: %v", err)
+	}
+
+	res := make([]*desc.SearchResult, 0, len(results))
+	for _, r := range results {
+		res = append(res, &desc.SearchResult{
+			ChatId:   r.ChatId,
+			ChatName: r.ChatName,
+			Snippet:  r.Snippet,
+			Message:  s.convertToProto(r.Message),
+		})
+	}
+
+	return &desc.SearchMessagesResponse{
+		Results: res,
+		HasMore: hasMore,
+	}, nil
+}
@@ -3,28 +3,51 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/service"
 	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*emptypb.Empty, error) {
-	// Но не проверяем From, так как его мы достаем из токена
-	if err := validateMessage(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
+func (s *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*desc.SendMessageResponse, error) {
 	// Достаем из токена username и uid пользователя
 	user, err := utils.GetUserClaimsFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
 	}
+
+	sent, err := s.sendMessage(ctx, user, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.SendMessageResponse{
+		MessageId:        sent.Id,
+		MentionedUserIds: sent.Mentions,
+		CreatedAt:        timestamppb.New(sent.CreatedAt),
+		Duplicate:        sent.Duplicate,
+		Seq:              sent.Seq,
+	}, nil
+}
+
+// sendMessage отправляет сообщение от имени пользователя и возвращает отправленное сообщение, ошибки уже в виде gRPC статуса.
+// Используется и в SendMessage, и в ChatStream
+func (s *Implementation) sendMessage(ctx context.Context, user *utils.UserClaims, req *desc.SendMessageRequest) (service.MessageDTO, error) {
+	// Но не проверяем From, так как его мы достаем из токена
+	if err := validateMessage(req); err != nil {
+		return service.MessageDTO{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// proto → service DTO
 	msg := service.SendMessageDTO{
 		ChatId:        req.ChatId,
@@ -36,22 +59,116 @@ func (s *Implementation) SendMessage(ctx context.Context, req *desc.SendMessageR
 		FileUrl:       req.FileUrl,
 		FileName:      req.FileName,
 		FileSize:      req.FileSize,
+		ReplyToId:     req.ReplyToMessageId,
+		ThreadRootId:  req.ThreadRootId,
+		ClientKey:     req.ClientKey,
+	}
+	if req.Type == desc.MessageType_MESSAGE_TYPE_POLL {
+		msg.Poll = &service.PollCreateDTO{
+			Options:        trimPollOptions(req.Poll.Options),
+			MultipleChoice: req.Poll.MultipleChoice,
+			Anonymous:      req.Poll.Anonymous,
+		}
+		if req.Poll.ClosesAt != nil {
+			msg.Poll.ClosesAt = req.Poll.ClosesAt.AsTime()
+		}
 	}
 
-	err = s.chatService.SendMessage(ctx, msg)
+	sent, err := s.chatService.SendMessage(ctx, msg)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to send message: %v", err)
+		if errors.Is(err, service.ErrMessageNotFound) {
+			return service.MessageDTO{}, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return service.MessageDTO{}, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return service.MessageDTO{}, status.Errorf(codes.Internal, "failed to send message: %v", err)
 	}
 
-	return &emptypb.Empty{}, nil
+	return sent, nil
 }
 
 func validateMessage(msg *desc.SendMessageRequest) error {
 	if msg.ChatId <= 0 {
 		return errors.New("chat_id cannot be empty")
 	}
+	// Служебные типы (события удаления, реакций и т.д.) рассылает только сервер
+	switch msg.Type {
+	case desc.MessageType_MESSAGE_TYPE_TEXT,
+		desc.MessageType_MESSAGE_TYPE_VOICE,
+		desc.MessageType_MESSAGE_TYPE_IMAGE,
+		desc.MessageType_MESSAGE_TYPE_FILE,
+		desc.MessageType_MESSAGE_TYPE_POLL:
+	default:
+		return fmt.Errorf("message type %s cannot be sent by client", msg.Type)
+	}
 	if msg.Text == "" {
 		return errors.New("text cannot be empty")
 	}
+	if msg.ClientKey != "" {
+		if err := uuid.Validate(msg.ClientKey); err != nil {
+			return fmt.Errorf("client_key must be a UUID: %w", err)
+		}
+	}
+	if msg.Type == desc.MessageType_MESSAGE_TYPE_POLL {
+		return validatePoll(msg.Poll)
+	}
+	if msg.Poll != nil {
+		return errors.New("poll is allowed only for poll messages")
+	}
 	return nil
 }
+
+const (
+	minPollOptions      = 2
+	maxPollOptions      = 10
+	maxPollOptionLength = 100
+	maxPollDuration     = 365 * 24 * time.Hour
+)
+
+// validatePoll проверяет варианты и время закрытия опроса, вопрос проверяется как текст сообщения
+func validatePoll(poll *desc.PollCreate) error {
+	if poll == nil {
+		return errors.New("poll cannot be empty")
+	}
+
+	options := trimPollOptions(poll.Options)
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return fmt.Errorf("poll must have from %d to %d options", minPollOptions, maxPollOptions)
+	}
+
+	seen := make(map[string]struct{}, len(options))
+	for _, option := range options {
+		if option == "" {
+			return errors.New("poll option cannot be empty")
+		}
+		if utf8.RuneCountInString(option) > maxPollOptionLength {
+			return fmt.Errorf("poll option cannot be longer than %d characters", maxPollOptionLength)
+		}
+		if _, ok := seen[option]; ok {
+			return fmt.Errorf("duplicate poll option %q", option)
+		}
+		seen[option] = struct{}{}
+	}
+
+	if poll.ClosesAt != nil {
+		closesAt := poll.ClosesAt.AsTime()
+		if !closesAt.After(time.Now()) {
+			return errors.New("poll closes_at must be in the future")
+		}
+		if time.Until(closesAt) > maxPollDuration {
+			return errors.New("poll closes_at is too far in the future")
+		}
+	}
+
+	return nil
+}
+
+// trimPollOptions убирает пробелы по краям вариантов опроса
+func trimPollOptions(options []string) []string {
+	res := make([]string, 0, len(options))
+	for _, option := range options {
+		res = append(res, strings.TrimSpace(option))
+	}
+	return res
+}
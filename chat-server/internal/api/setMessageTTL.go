@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	minMessageTTL = 5 * time.Second
+	maxMessageTTL = 365 * 24 * time.Hour
+)
+
+func (s *Implementation) SetMessageTTL(ctx context.Context, req *desc.SetMessageTTLRequest) (*emptypb.Empty, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl != 0 && (ttl < minMessageTTL || ttl > maxMessageTTL) {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl must be 0 or from %v to %v", minMessageTTL, maxMessageTTL)
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	err = s.chatService.SetMessageTTL(ctx, user.UID, req.ChatId, ttl)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set message ttl: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/utils"
+	desc "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Implementation) SetTyping(ctx context.Context, req *desc.SetTypingRequest) (*emptypb.Empty, error) {
+	if req.ChatId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "chat id is required")
+	}
+
+	user, err := utils.GetUserClaimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get user claims from token: %v", err)
+	}
+
+	if err := s.setTyping(ctx, user, req.ChatId, req.IsTyping); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// setTyping передает событие "печатает" в сервис, ошибки уже в виде gRPC статуса
+func (s *Implementation) setTyping(ctx context.Context, user *utils.UserClaims, chatId int64, isTyping bool) error {
+	err := s.chatService.SetTyping(ctx, chatId, user.UID, user.Username, isTyping)
+	if err != nil {
+		if errors.Is(err, service.ErrPermissionDenied) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Errorf(codes.Internal, "failed to set typing: %v", err)
+	}
+
+	return nil
+}
@@ -20,18 +20,50 @@ func (s *Implementation) UnreadCounts(ctx context.Context, req *desc.UnreadCount
 		return nil, status.Errorf(codes.Internal, "failed to get unread counts: %v", err)
 	}
 
+	mentionCounts, err := s.chatService.MentionCounts(ctx, userId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mention counts: %v", err)
+	}
+
 	var res []*desc.UnreadCounts
-	var total int32
+	var total, mentionTotal int32
 	for chatId, count := range counts {
 		res = append(res, &desc.UnreadCounts{
-			ChatId: chatId,
-			Count:  count,
+			ChatId:       chatId,
+			Count:        count,
+			MentionCount: mentionCounts[chatId],
 		})
 		total += count
 	}
+	for chatId, count := range mentionCounts {
+		mentionTotal += count
+
+		// Упоминание в треде может быть и без непрочитанных в ленте
+		if _, ok := counts[chatId]; !ok {
+			res = append(res, &desc.UnreadCounts{
+				ChatId:       chatId,
+				MentionCount: count,
+			})
+		}
+	}
+
+	threadCounts, err := s.chatService.ThreadUnreadCounts(ctx, userId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get thread unread counts: %v", err)
+	}
+
+	threads := make([]*desc.ThreadUnreadCounts, 0, len(threadCounts))
+	for rootId, count := range threadCounts {
+		threads = append(threads, &desc.ThreadUnreadCounts{
+			ThreadRootId: rootId,
+			Count:        count,
+		})
+	}
 
 	return &desc.UnreadCountsResponse{
-		UnreadCounts: res,
-		Total:        total,
+		UnreadCounts:       res,
+		Total:              total,
+		ThreadUnreadCounts: threads,
+		MentionTotal:       mentionTotal,
 	}, nil
 }
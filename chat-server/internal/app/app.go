@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/closer"
 	"github.com/GolZrd/micro-chat/chat-server/internal/interceptor"
@@ -55,9 +56,68 @@ func (a *App) Run() error {
 		}
 	}()
 
+	a.RunBackgroundWorkers()
+
 	return a.RunGRPCServer()
 }
 
+// Пауза перед перезапуском слушателя шины удваивается до максимума при повторных падениях
+const (
+	broadcastMinBackoff = time.Second
+	broadcastMaxBackoff = 30 * time.Second
+)
+
+// RunBackgroundWorkers запускает фоновые задачи сервиса, они останавливаются при закрытии приложения
+func (a *App) RunBackgroundWorkers() {
+	ctx, cancel := context.WithCancel(context.Background())
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+
+	chatService := a.serviceProvider.ChatService(ctx)
+
+	go func() {
+		// Без шины сообщения не дойдут до подписчиков других реплик, поэтому слушателя перезапускаем,
+		// пока приложение не закроется. Локальная доставка тем временем продолжает работать
+		backoff := broadcastMinBackoff
+		for {
+			startedAt := time.Now()
+			err := chatService.RunBroadcastListener(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+
+			// Слушатель успел поработать - значит redis был доступен, начинаем ожидание заново
+			if time.Since(startedAt) > broadcastMaxBackoff {
+				backoff = broadcastMinBackoff
+			}
+
+			logger.Error("broadcast listener stopped, restarting", zap.Duration("retry_in", backoff), zap.Error(err))
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff = min(backoff*2, broadcastMaxBackoff)
+		}
+	}()
+
+	go func() {
+		if err := chatService.RunScheduledDispatcher(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("scheduled dispatcher stopped", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		if err := chatService.RunMessageReaper(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("message reaper stopped", zap.Error(err))
+		}
+	}()
+}
+
 // инициализируем зависимости
 func (a *App) InitDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
@@ -9,10 +9,14 @@ import (
 	"github.com/GolZrd/micro-chat/chat-server/internal/closer"
 	"github.com/GolZrd/micro-chat/chat-server/internal/config"
 	"github.com/GolZrd/micro-chat/chat-server/internal/interceptor"
+	"github.com/GolZrd/micro-chat/chat-server/internal/linkpreview"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository/broadcast"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository/presence"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository/scheduled"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository/unread"
 	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/GolZrd/micro-chat/chat-server/internal/storage"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -27,11 +31,15 @@ type serviceProvider struct {
 	authClient      *auth.Client
 	authInterceptor *interceptor.AuthInterceptor
 
-	chatRepository     repository.ChatRepository
-	presenceRepository presence.RedisRepository
-	unreadRepository   unread.UnreadRepository
-	chatService        service.ChatService
-	chatImpl           *api.Implementation
+	chatRepository      repository.ChatRepository
+	presenceRepository  presence.RedisRepository
+	unreadRepository    unread.UnreadRepository
+	scheduledRepository scheduled.ScheduledRepository
+	broadcastBus        broadcast.Bus
+	fileStorage         storage.Storage
+	linkPreviews        linkpreview.Fetcher
+	chatService         service.ChatService
+	chatImpl            *api.Implementation
 }
 
 func newServiceProvider() *serviceProvider {
@@ -152,9 +160,48 @@ func (s *serviceProvider) UnreadRepository(ctx context.Context) unread.UnreadRep
 	return s.unreadRepository
 }
 
+func (s *serviceProvider) ScheduledRepository(ctx context.Context) scheduled.ScheduledRepository {
+	if s.scheduledRepository == nil {
+		s.scheduledRepository = scheduled.NewScheduledRepository(s.PgPool(ctx))
+	}
+
+	return s.scheduledRepository
+}
+
+func (s *serviceProvider) BroadcastBus(redisClient *redis.Client) broadcast.Bus {
+	if s.broadcastBus == nil {
+		s.broadcastBus = broadcast.NewRedisBus(redisClient)
+	}
+
+	return s.broadcastBus
+}
+
+func (s *serviceProvider) FileStorage() storage.Storage {
+	if s.fileStorage == nil {
+		cfg := s.Config()
+		fileStorage, err := storage.NewMinioStorage(cfg.MinioEndpoint, cfg.MinioAccessKey, cfg.MinioSecretKey, cfg.MinioBucket)
+		if err != nil {
+			log.Fatalf("failed to create file storage: %v", err)
+		}
+		s.fileStorage = fileStorage
+	}
+
+	return s.fileStorage
+}
+
+func (s *serviceProvider) LinkPreviews() linkpreview.Fetcher {
+	if s.linkPreviews == nil {
+		s.linkPreviews = linkpreview.NewFetcher(linkpreview.Config{
+			Timeout: s.Config().LinkPreviewTimeout,
+		})
+	}
+
+	return s.linkPreviews
+}
+
 func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 	if s.chatService == nil {
-		s.chatService = service.NewService(s.ChatRepository(ctx), s.PresenceRepository(s.RedisClient()), s.UnreadRepository(ctx), s.AuthClient())
+		s.chatService = service.NewService(s.ChatRepository(ctx), s.PresenceRepository(s.RedisClient()), s.UnreadRepository(ctx), s.ScheduledRepository(ctx), s.BroadcastBus(s.RedisClient()), s.FileStorage(), s.LinkPreviews(), s.AuthClient(), s.Config())
 	}
 
 	return s.chatService
@@ -2,8 +2,10 @@ package config
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config — структура для всех настроек
@@ -19,6 +21,17 @@ type Config struct {
 	RedisAddr     string
 	RedisPassword string
 	RedisDB       int
+
+	MinioEndpoint  string
+	MinioAccessKey string
+	MinioSecretKey string
+	MinioBucket    string
+
+	MessageEditWindow         time.Duration // Сколько времени после отправки можно редактировать сообщение, 0 - без ограничений
+	ScheduledDispatchInterval time.Duration // Как часто проверять отложенные сообщения
+	MessageReaperInterval     time.Duration // Как часто удалять истекшие сообщения
+	LinkPreviewTimeout        time.Duration // Сколько ждать страницу для превью ссылки
+	ChatRestoreWindow         time.Duration // Сколько удаленный чат можно восстановить, 0 - чат стирается сразу
 }
 
 // Load загружает конфиг
@@ -33,6 +46,15 @@ func Load() (*Config, error) {
 		GRPCAuthPort:  os.Getenv("GRPC_AUTH_PORT"),
 		RedisAddr:     os.Getenv("REDIS_ADDR"),
 		RedisPassword: os.Getenv("REDIS_PASSWORD"),
+
+		MinioEndpoint:  os.Getenv("MINIO_ENDPOINT"),
+		MinioAccessKey: os.Getenv("MINIO_ACCESS_KEY"),
+		MinioSecretKey: os.Getenv("MINIO_SECRET_KEY"),
+		MinioBucket:    os.Getenv("MINIO_BUCKET"),
+	}
+
+	if cfg.MinioBucket == "" {
+		cfg.MinioBucket = "chat-files"
 	}
 
 	redisDB := os.Getenv("REDIS_CHAT_DB")
@@ -40,6 +62,50 @@ func Load() (*Config, error) {
 		cfg.RedisDB, _ = strconv.Atoi(redisDB)
 	}
 
+	// 0 - редактировать можно без ограничения, поэтому опечатку не превращаем в ноль, а оставляем значение по умолчанию.
+	// Конфиг читается до инициализации zap, поэтому пишем через стандартный log
+	cfg.MessageEditWindow = 48 * time.Hour
+	editWindow := os.Getenv("MESSAGE_EDIT_WINDOW")
+	if editWindow != "" {
+		if window, err := time.ParseDuration(editWindow); err == nil && window >= 0 {
+			cfg.MessageEditWindow = window
+		} else {
+			log.Printf("invalid MESSAGE_EDIT_WINDOW %q, using default %s", editWindow, cfg.MessageEditWindow)
+		}
+	}
+
+	cfg.ScheduledDispatchInterval = 5 * time.Second
+	dispatchInterval := os.Getenv("SCHEDULED_DISPATCH_INTERVAL")
+	if dispatchInterval != "" {
+		if interval, err := time.ParseDuration(dispatchInterval); err == nil && interval > 0 {
+			cfg.ScheduledDispatchInterval = interval
+		}
+	}
+
+	cfg.MessageReaperInterval = 30 * time.Second
+	reaperInterval := os.Getenv("MESSAGE_REAPER_INTERVAL")
+	if reaperInterval != "" {
+		if interval, err := time.ParseDuration(reaperInterval); err == nil && interval > 0 {
+			cfg.MessageReaperInterval = interval
+		}
+	}
+
+	cfg.LinkPreviewTimeout = 5 * time.Second
+	previewTimeout := os.Getenv("LINK_PREVIEW_TIMEOUT")
+	if previewTimeout != "" {
+		if timeout, err := time.ParseDuration(previewTimeout); err == nil && timeout > 0 {
+			cfg.LinkPreviewTimeout = timeout
+		}
+	}
+
+	cfg.ChatRestoreWindow = 24 * time.Hour
+	restoreWindow := os.Getenv("CHAT_RESTORE_WINDOW")
+	if restoreWindow != "" {
+		if window, err := time.ParseDuration(restoreWindow); err == nil && window >= 0 {
+			cfg.ChatRestoreWindow = window
+		}
+	}
+
 	cfg.DB_DSN = fmt.Sprintf("host=%s port=%s dbname=%s user=%s password=%s sslmode=disable", cfg.DBHost, cfg.DBPort, cfg.DBName, cfg.DBUser, cfg.DBPassword)
 
 	return cfg, nil
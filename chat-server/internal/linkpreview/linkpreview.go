@@ -0,0 +1,347 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	// ErrBlockedAddress возвращается, если ссылка ведет во внутреннюю сеть
+	ErrBlockedAddress = errors.New("address is not allowed")
+	// ErrNoPreview возвращается, если на странице нечего показать
+	ErrNoPreview = errors.New("no preview")
+)
+
+const (
+	maxRedirects      = 3
+	maxTitleLength    = 200
+	maxDescLength     = 500
+	defaultTimeout    = 5 * time.Second
+	defaultMaxBody    = 1 << 20 // 1 МБ, мета-теги почти всегда в первых килобайтах
+	defaultUserAgent  = "micro-chat-linkpreview/1.0"
+	maxURLLength      = 2048
+	acceptHeaderValue = "text/html,application/xhtml+xml"
+)
+
+// urlPattern первая http(s) ссылка в тексте сообщения
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+// Preview превью страницы по OpenGraph и обычным мета-тегам
+type Preview struct {
+	Url         string
+	Title       string
+	Description string
+	ImageUrl    string
+	SiteName    string
+}
+
+// Fetcher загружает превью ссылок
+type Fetcher interface {
+	Fetch(ctx context.Context, rawUrl string) (Preview, error)
+}
+
+// Config настройки загрузки превью, нулевые значения заменяются значениями по умолчанию
+type Config struct {
+	Timeout      time.Duration // Общее время на соединение, редиректы и чтение ответа
+	MaxBodyBytes int64         // Сколько байт страницы читаем в поисках мета-тегов
+	AllowPrivate bool          // Разрешить адреса внутренней сети. Только для тестов и локальной разработки
+}
+
+type fetcher struct {
+	client       *http.Client
+	timeout      time.Duration
+	maxBodyBytes int64
+}
+
+func NewFetcher(cfg Config) Fetcher {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = defaultMaxBody
+	}
+
+	dialer := &net.Dialer{
+		Timeout: cfg.Timeout,
+	}
+	// Проверяем адрес уже после DNS, на каждое соединение, включая редиректы.
+	// Так ссылку не увести во внутреннюю сеть ни через DNS, ни через редирект
+	if !cfg.AllowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return fmt.Errorf("split address %q: %w", address, err)
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%s: %w", address, ErrBlockedAddress)
+			}
+			return nil
+		}
+	}
+
+	transport := &http.Transport{
+		Proxy:                  nil, // Прокси из окружения обошел бы проверку адреса
+		DialContext:            dialer.DialContext,
+		TLSHandshakeTimeout:    cfg.Timeout,
+		ResponseHeaderTimeout:  cfg.Timeout,
+		MaxResponseHeaderBytes: 64 << 10,
+		MaxIdleConns:           10,
+		IdleConnTimeout:        30 * time.Second,
+	}
+
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   cfg.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to scheme %q: %w", req.URL.Scheme, ErrBlockedAddress)
+			}
+			return nil
+		},
+	}
+
+	return &fetcher{
+		client:       client,
+		timeout:      cfg.Timeout,
+		maxBodyBytes: cfg.MaxBodyBytes,
+	}
+}
+
+// Fetch загружает страницу и достает из нее заголовок, описание и картинку
+func (f *fetcher) Fetch(ctx context.Context, rawUrl string) (Preview, error) {
+	if len(rawUrl) > maxURLLength {
+		return Preview{}, fmt.Errorf("url is too long: %w", ErrNoPreview)
+	}
+
+	pageUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return Preview{}, fmt.Errorf("parse url: %w", err)
+	}
+	if pageUrl.Scheme != "http" && pageUrl.Scheme != "https" {
+		return Preview{}, fmt.Errorf("scheme %q: %w", pageUrl.Scheme, ErrBlockedAddress)
+	}
+	if pageUrl.Hostname() == "" {
+		return Preview{}, fmt.Errorf("empty host: %w", ErrNoPreview)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageUrl.String(), nil)
+	if err != nil {
+		return Preview{}, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("User-Agent", defaultUserAgent)
+	req.Header.Set("Accept", acceptHeaderValue)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return Preview{}, fmt.Errorf("fetch %s: %w", pageUrl.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Preview{}, fmt.Errorf("unexpected status %d: %w", resp.StatusCode, ErrNoPreview)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return Preview{}, fmt.Errorf("content type %q: %w", mediaType, ErrNoPreview)
+	}
+
+	// Относительные ссылки на картинку считаем от адреса после редиректов
+	preview := parseHead(io.LimitReader(resp.Body, f.maxBodyBytes), resp.Request.URL)
+	preview.Url = rawUrl
+
+	if preview.Title == "" && preview.Description == "" {
+		return Preview{}, ErrNoPreview
+	}
+
+	return preview, nil
+}
+
+// parseHead читает мета-теги из head страницы. OpenGraph приоритетнее обычных тегов
+func parseHead(body io.Reader, pageUrl *url.URL) Preview {
+	var preview Preview
+	var title, description string
+
+	tokenizer := html.NewTokenizer(body)
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			// Конец документа или лимит размера - отдаем то, что успели найти
+			return finishPreview(preview, title, description)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			switch string(name) {
+			case "body":
+				return finishPreview(preview, title, description)
+			case "title":
+				if title == "" && tokenizer.Next() == html.TextToken {
+					title = string(tokenizer.Text())
+				}
+			case "meta":
+				if !hasAttr {
+					continue
+				}
+				attrs := tagAttrs(tokenizer)
+				key := attrs["property"]
+				if key == "" {
+					key = attrs["name"]
+				}
+				content := attrs["content"]
+				switch strings.ToLower(key) {
+				case "og:title":
+					preview.Title = content
+				case "og:description":
+					preview.Description = content
+				case "og:site_name":
+					preview.SiteName = content
+				case "og:image", "og:image:url":
+					if preview.ImageUrl == "" {
+						preview.ImageUrl = resolveImage(pageUrl, content)
+					}
+				case "description":
+					description = content
+				}
+			}
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); string(name) == "head" {
+				return finishPreview(preview, title, description)
+			}
+		}
+	}
+}
+
+// finishPreview подставляет обычные теги вместо отсутствующих OpenGraph и обрезает длинные значения
+func finishPreview(preview Preview, title, description string) Preview {
+	if preview.Title == "" {
+		preview.Title = title
+	}
+	if preview.Description == "" {
+		preview.Description = description
+	}
+
+	preview.Title = truncate(strings.Join(strings.Fields(preview.Title), " "), maxTitleLength)
+	preview.Description = truncate(strings.Join(strings.Fields(preview.Description), " "), maxDescLength)
+	preview.SiteName = truncate(strings.TrimSpace(preview.SiteName), maxTitleLength)
+
+	return preview
+}
+
+// tagAttrs атрибуты текущего тега, имена в нижнем регистре
+func tagAttrs(tokenizer *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, val, more := tokenizer.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(val)
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// resolveImage абсолютный http(s) адрес картинки, пустая строка если адрес не подходит
+func resolveImage(pageUrl *url.URL, raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || len(raw) > maxURLLength {
+		return ""
+	}
+
+	ref, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+
+	image := pageUrl.ResolveReference(ref)
+	if image.Scheme != "http" && image.Scheme != "https" {
+		return ""
+	}
+
+	return image.String()
+}
+
+// FirstURL первая http(s) ссылка в тексте, пустая строка если ссылок нет.
+// Знаки препинания в конце считаются частью предложения, а не ссылки
+func FirstURL(text string) string {
+	match := urlPattern.FindString(text)
+	if match == "" {
+		return ""
+	}
+
+	match = strings.TrimRight(match, ".,;:!?")
+	// Закрывающая скобка без открывающей - скобка вокруг ссылки
+	for strings.HasSuffix(match, ")") && strings.Count(match, "(") < strings.Count(match, ")") {
+		match = strings.TrimSuffix(match, ")")
+	}
+
+	return match
+}
+
+// isPublicIP false для адресов, которые не должны быть доступны по ссылке из сообщения
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, block := range blockedNets {
+		if block.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// blockedNets специальные диапазоны, которые не покрывают методы net.IP
+var blockedNets = mustParseCIDRs(
+	"0.0.0.0/8",       // "Эта" сеть
+	"100.64.0.0/10",   // CGNAT
+	"192.0.0.0/24",    // IETF
+	"192.0.2.0/24",    // TEST-NET-1
+	"198.18.0.0/15",   // Тестирование производительности
+	"198.51.100.0/24", // TEST-NET-2
+	"203.0.113.0/24",  // TEST-NET-3
+	"240.0.0.0/4",     // Зарезервировано
+	"64:ff9b::/96",    // NAT64 может вести на внутренний IPv4
+	"2001:db8::/32",   // Документация
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	res := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, block, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		res = append(res, block)
+	}
+	return res
+}
+
+// truncate обрезает строку до max символов
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
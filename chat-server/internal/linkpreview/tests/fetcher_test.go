@@ -0,0 +1,197 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/linkpreview"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch(t *testing.T) {
+	tests := []struct {
+		name            string
+		contentType     string
+		status          int
+		body            string
+		maxBodyBytes    int64
+		expectedPreview linkpreview.Preview
+		expectedErr     error
+	}{
+		{
+			name:        "success case - open graph tags",
+			contentType: "text/html; charset=utf-8",
+			status:      http.StatusOK,
+			body: `<html><head>
+				<title>Fallback title</title>
+				<meta property="og:title" content="OG title">
+				<meta property="og:description" content="OG   description">
+				<meta property="og:site_name" content="Example">
+				<meta property="og:image" content="/images/cover.png">
+				</head><body>text</body></html>`,
+			expectedPreview: linkpreview.Preview{
+				Title:       "OG title",
+				Description: "OG description",
+				SiteName:    "Example",
+				ImageUrl:    "/images/cover.png", // Дополняется адресом сервера в самом тесте
+			},
+		},
+		{
+			name:        "success case - fallback to title and meta description",
+			contentType: "text/html",
+			status:      http.StatusOK,
+			body: `<!doctype html><html><head>
+				<title> Plain   title </title>
+				<meta name="description" content="Plain description">
+				</head></html>`,
+			expectedPreview: linkpreview.Preview{
+				Title:       "Plain title",
+				Description: "Plain description",
+			},
+		},
+		{
+			name:        "error case - tags after body are ignored",
+			contentType: "text/html",
+			status:      http.StatusOK,
+			body:        `<html><body><meta property="og:title" content="Too late"></body></html>`,
+			expectedErr: linkpreview.ErrNoPreview,
+		},
+		{
+			name:        "error case - not html",
+			contentType: "application/json",
+			status:      http.StatusOK,
+			body:        `{"title": "json"}`,
+			expectedErr: linkpreview.ErrNoPreview,
+		},
+		{
+			name:        "error case - not found",
+			contentType: "text/html",
+			status:      http.StatusNotFound,
+			body:        `<html><head><title>Not found</title></head></html>`,
+			expectedErr: linkpreview.ErrNoPreview,
+		},
+		{
+			name:         "error case - tags beyond body size limit",
+			contentType:  "text/html",
+			status:       http.StatusOK,
+			body:         `<html><head><!--` + strings.Repeat("x", 4096) + `--><title>Hidden</title></head></html>`,
+			maxBodyBytes: 1024,
+			expectedErr:  linkpreview.ErrNoPreview,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			// httptest слушает loopback, поэтому разрешаем внутренние адреса
+			fetcher := linkpreview.NewFetcher(linkpreview.Config{
+				Timeout:      time.Second,
+				MaxBodyBytes: tt.maxBodyBytes,
+				AllowPrivate: true,
+			})
+
+			preview, err := fetcher.Fetch(context.Background(), server.URL+"/page")
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			expected := tt.expectedPreview
+			expected.Url = server.URL + "/page"
+			if expected.ImageUrl != "" {
+				expected.ImageUrl = server.URL + expected.ImageUrl
+			}
+			require.Equal(t, expected, preview)
+		})
+	}
+}
+
+func TestFetchBlocksPrivateAddresses(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<html><head><title>Internal</title></head></html>`))
+	}))
+	defer server.Close()
+
+	fetcher := linkpreview.NewFetcher(linkpreview.Config{Timeout: time.Second})
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "loopback", url: server.URL},
+		{name: "localhost name", url: strings.Replace(server.URL, "127.0.0.1", "localhost", 1)},
+		{name: "private range", url: "http://10.0.0.1/"},
+		{name: "metadata service", url: "http://169.254.169.254/latest/meta-data/"},
+		{name: "ipv6 loopback", url: "http://[::1]/"},
+		{name: "not http scheme", url: "file:///etc/passwd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := fetcher.Fetch(context.Background(), tt.url)
+			require.ErrorIs(t, err, linkpreview.ErrBlockedAddress)
+		})
+	}
+
+	require.False(t, called, "request must not reach internal server")
+}
+
+func TestFetchTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	fetcher := linkpreview.NewFetcher(linkpreview.Config{
+		Timeout:      100 * time.Millisecond,
+		AllowPrivate: true,
+	})
+
+	start := time.Now()
+	_, err := fetcher.Fetch(context.Background(), server.URL)
+
+	require.Error(t, err)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestFirstURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "no url", text: "просто текст", expected: ""},
+		{name: "url in text", text: "смотри https://example.com/a?b=c тут", expected: "https://example.com/a?b=c"},
+		{name: "trailing punctuation", text: "вот http://example.com/page.", expected: "http://example.com/page"},
+		{name: "url in parentheses", text: "(см. https://example.com/x)", expected: "https://example.com/x"},
+		{name: "parentheses inside url", text: "https://en.wikipedia.org/wiki/Go_(language)", expected: "https://en.wikipedia.org/wiki/Go_(language)"},
+		{name: "first of several", text: "https://a.example https://b.example", expected: "https://a.example"},
+		{name: "not http", text: "ftp://example.com", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, linkpreview.FirstURL(tt.text))
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package broadcast
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	eventsPrefix   = "chat:events:"   // канал событий конкретного чата
+	onlinePrefix   = "chat:online:"   // hash онлайн пользователей чата: "<instance>:<user_id>" → username
+	instancePrefix = "chat:instance:" // ключ жизни реплики, пока он есть - записи реплики считаются актуальными
+	instanceTTL    = 30 * time.Second // Если реплика не обновила ключ за 30 сек, считаем что она упала
+)
+
+// Event событие, полученное от другой реплики
+type Event struct {
+	ChatId  int64
+	Payload []byte
+}
+
+type OnlineUser struct {
+	UserId   int64
+	Username string
+}
+
+// Bus шина для рассылки событий чатов между всеми репликами chat-server
+type Bus interface {
+	Publish(ctx context.Context, chatId int64, payload []byte) error
+	Listen(ctx context.Context, handler func(Event)) error
+	AddOnline(ctx context.Context, chatId int64, userId int64, username string) error
+	RemoveOnline(ctx context.Context, chatId int64, userId int64) error
+	OnlineUsers(ctx context.Context, chatId int64) ([]OnlineUser, error)
+}
+
+type RedisBus struct {
+	client     *redis.Client
+	instanceId string
+}
+
+// envelope - то, что реально уходит в redis, origin нужен чтобы реплика не обрабатывала свои же события
+type envelope struct {
+	Origin  string          `json:"origin"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func NewRedisBus(client *redis.Client) Bus {
+	return &RedisBus{client: client, instanceId: newInstanceId()}
+}
+
+func newInstanceId() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Publish публикует событие чата для всех реплик
+func (b *RedisBus) Publish(ctx context.Context, chatId int64, payload []byte) error {
+	data, err := json.Marshal(envelope{Origin: b.instanceId, Payload: payload})
+	if err != nil {
+		return fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	err = b.client.Publish(ctx, fmt.Sprintf("%s%d", eventsPrefix, chatId), data).Err()
+	if err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+
+	return nil
+}
+
+// Listen подписывается на события всех чатов и вызывает handler для событий других реплик.
+// Блокируется до отмены контекста, параллельно поддерживает ключ жизни реплики.
+func (b *RedisBus) Listen(ctx context.Context, handler func(Event)) error {
+	if err := b.refreshInstance(ctx); err != nil {
+		return err
+	}
+
+	pubsub := b.client.PSubscribe(ctx, eventsPrefix+"*")
+	defer pubsub.Close()
+
+	ticker := time.NewTicker(instanceTTL / 3)
+	defer ticker.Stop()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			// Убираем ключ жизни, чтобы онлайн этой реплики сразу перестал учитываться
+			b.client.Del(context.Background(), instancePrefix+b.instanceId)
+			return nil
+		case <-ticker.C:
+			// Один неудачный SET не повод переставать слушать: ключ живет instanceTTL, следующий тик повторит запись
+			if err := b.refreshInstance(ctx); err != nil && ctx.Err() == nil {
+				logger.Warn("failed to refresh broadcast instance key", zap.String("instance_id", b.instanceId), zap.Error(err))
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("pubsub channel closed")
+			}
+
+			chatId, err := strconv.ParseInt(strings.TrimPrefix(msg.Channel, eventsPrefix), 10, 64)
+			if err != nil {
+				continue
+			}
+
+			var env envelope
+			if err := json.Unmarshal([]byte(msg.Payload), &env); err != nil {
+				continue
+			}
+
+			// Свои события уже доставлены локально
+			if env.Origin == b.instanceId {
+				continue
+			}
+
+			handler(Event{ChatId: chatId, Payload: env.Payload})
+		}
+	}
+}
+
+func (b *RedisBus) refreshInstance(ctx context.Context) error {
+	err := b.client.Set(ctx, instancePrefix+b.instanceId, "1", instanceTTL).Err()
+	if err != nil {
+		return fmt.Errorf("refresh instance: %w", err)
+	}
+	return nil
+}
+
+// AddOnline отмечает пользователя онлайн в чате на этой реплике
+func (b *RedisBus) AddOnline(ctx context.Context, chatId int64, userId int64, username string) error {
+	field := fmt.Sprintf("%s:%d", b.instanceId, userId)
+
+	err := b.client.HSet(ctx, fmt.Sprintf("%s%d", onlinePrefix, chatId), field, username).Err()
+	if err != nil {
+		return fmt.Errorf("add online: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveOnline убирает пользователя из онлайна чата на этой реплике
+func (b *RedisBus) RemoveOnline(ctx context.Context, chatId int64, userId int64) error {
+	field := fmt.Sprintf("%s:%d", b.instanceId, userId)
+
+	err := b.client.HDel(ctx, fmt.Sprintf("%s%d", onlinePrefix, chatId), field).Err()
+	if err != nil {
+		return fmt.Errorf("remove online: %w", err)
+	}
+
+	return nil
+}
+
+// OnlineUsers возвращает онлайн пользователей чата со всех живых реплик
+func (b *RedisBus) OnlineUsers(ctx context.Context, chatId int64) ([]OnlineUser, error) {
+	key := fmt.Sprintf("%s%d", onlinePrefix, chatId)
+
+	fields, err := b.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get online users: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	// Проверяем одним pipeline какие реплики живы
+	pipe := b.client.Pipeline()
+	instances := make(map[string]*redis.IntCmd)
+	for field := range fields {
+		instance, _, _ := strings.Cut(field, ":")
+		if _, ok := instances[instance]; !ok {
+			instances[instance] = pipe.Exists(ctx, instancePrefix+instance)
+		}
+	}
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check instances: %w", err)
+	}
+
+	var stale []string
+	seen := make(map[int64]struct{}, len(fields))
+	users := make([]OnlineUser, 0, len(fields))
+
+	for field, username := range fields {
+		instance, userIdStr, _ := strings.Cut(field, ":")
+		if instances[instance].Val() == 0 {
+			stale = append(stale, field)
+			continue
+		}
+
+		userId, err := strconv.ParseInt(userIdStr, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// Пользователь может быть подключен к нескольким репликам
+		if _, ok := seen[userId]; ok {
+			continue
+		}
+		seen[userId] = struct{}{}
+
+		users = append(users, OnlineUser{UserId: userId, Username: username})
+	}
+
+	// Чистим записи упавших реплик
+	if len(stale) > 0 {
+		b.client.HDel(ctx, key, stale...)
+	}
+
+	return users, nil
+}
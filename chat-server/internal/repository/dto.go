@@ -8,7 +8,9 @@ type CreateChatDTO struct {
 	IsGroup   bool // true = групповой, false = личный
 	IsPublic  bool
 	CreatorId int64
-	Members   []MemberDTO
+
+	JoinApproval bool // Вступление в открытый чат через заявку
+	Members      []MemberDTO
 }
 
 // MessageCreateDTO - DTO для сохранения сообщения
@@ -22,12 +24,18 @@ type MessageCreateDTO struct {
 	FileUrl       string
 	FileName      string
 	FileSize      int64
+	ReplyToId     *int64            // nil если это не ответ
+	ThreadRootId  *int64            // nil если сообщение не в треде
+	ForwardedFrom *ForwardedFromDTO // nil если сообщение не пересланное
+	Poll          *PollCreateDTO    // Только для сообщения с типом poll
+	ClientKey     *string           // Ключ идемпотентности от клиента, nil если не передан
 }
 
 // MessageDTO - DTO для получения сообщения
 type MessageDTO struct {
 	Id            int64
 	ChatId        int64
+	Seq           int64 // Порядковый номер в чате
 	UserId        int64
 	From          string
 	Text          string
@@ -37,11 +45,113 @@ type MessageDTO struct {
 	FileUrl       string
 	FileName      string
 	FileSize      int64
+	EditedAt      *time.Time // nil если сообщение не редактировалось
+	DeletedAt     *time.Time // не nil если сообщение удалено для всех
+	ReplyTo       *ReplyPreviewDTO
+
+	ThreadRootId      *int64     // nil если сообщение в ленте чата
+	ThreadReplyCount  int32      // Для корня треда
+	ThreadLastReplyAt *time.Time // Для корня треда, nil если ответов нет
+
+	ForwardedFrom *ForwardedFromDTO // nil если сообщение не пересланное
+	ExpiresAt     *time.Time        // nil если сообщение не исчезает
+	LinkPreview   *LinkPreviewDTO   // nil если превью ссылки нет или оно еще не загружено
+}
+
+// LinkPreviewDTO - превью ссылки из текста сообщения
+type LinkPreviewDTO struct {
+	Url         string
+	Title       string
+	Description string
+	ImageUrl    string
+	SiteName    string
+}
+
+// SentMessageDTO - сохраненное сообщение: id и значения, которые выставила БД
+type SentMessageDTO struct {
+	Id        int64
+	Seq       int64 // Порядковый номер в чате
+	CreatedAt time.Time
+	ExpiresAt *time.Time // nil если у чата не задано время жизни сообщений
+	Duplicate bool       // Сообщение с этим ключом идемпотентности уже было сохранено раньше
+}
+
+// ExpiredMessageDTO - удаленное по истечении времени жизни сообщение
+type ExpiredMessageDTO struct {
+	Id           int64
+	ChatId       int64
+	ThreadRootId *int64
+	FileUrl      string
+}
+
+// LeaveResultDTO - итог выхода участника из чата
+type LeaveResultDTO struct {
+	Role       string // Роль вышедшего
+	NewOwnerId int64  // Новый владелец, если вышел владелец и в чате кто-то остался
+	Remaining  int    // Сколько участников осталось
+}
+
+// PurgedChatDTO - окончательно удаленный чат и файлы его сообщений
+type PurgedChatDTO struct {
+	Id       int64
+	FileUrls []string
+}
+
+// ForwardedFromDTO - источник пересланного сообщения
+type ForwardedFromDTO struct {
+	From      string // Автор исходного сообщения
+	ChatId    int64
+	MessageId *int64 // nil если исходное сообщение удалено из БД
+}
+
+// ReplyPreviewDTO - превью сообщения, на которое отвечают
+type ReplyPreviewDTO struct {
+	MessageId   int64
+	From        string
+	Text        string // Первые 100 символов
+	MessageType string
+	Deleted     bool
+}
+
+// PollCreateDTO - параметры нового опроса, вопрос хранится в тексте сообщения
+type PollCreateDTO struct {
+	Options        []string
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       *time.Time // nil если опрос закрывается только вручную
+}
+
+// PollDTO - опрос с подсчитанными голосами
+type PollDTO struct {
+	MessageId      int64
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       *time.Time
+	Closed         bool  // Закрыт вручную или наступило ClosesAt
+	TotalVoters    int32 // Сколько участников проголосовало хотя бы за один вариант
+	Options        []PollOptionDTO
+}
+
+// PollOptionDTO - вариант ответа и голоса за него
+type PollOptionDTO struct {
+	Id        int64
+	Text      string
+	Votes     int32
+	VotedByMe bool
+	VoterIds  []int64 // Пусто в анонимном опросе
+}
+
+// ReactionDTO - сводка по одному эмодзи на сообщении
+type ReactionDTO struct {
+	Emoji       string
+	Count       int32
+	ReactedByMe bool
 }
 
 type MemberDTO struct {
 	UserId   int64
 	Username string
+	Role     string
 }
 
 // ChatInfoDTO - DTO для получения информации о чате
@@ -54,14 +164,20 @@ type ChatInfoDTO struct {
 	Members   []MemberDTO
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	MessageTTLSeconds int32 // Время жизни новых сообщений, 0 - сообщения не исчезают
+	JoinApproval      bool  // Вступление в открытый чат через заявку
+
+	PurgeAt *time.Time // Только у удаленного чата: когда он будет стерт без возможности восстановления
 }
 
 type PublicChatDTO struct {
-	Id          int64
-	Name        string
-	MemberCount int
-	CreatorName string
-	CreatedAt   time.Time
+	Id           int64
+	Name         string
+	MemberCount  int
+	CreatorName  string
+	CreatedAt    time.Time
+	JoinApproval bool
 }
 
 type LastMessageDTO struct {
@@ -70,3 +186,72 @@ type LastMessageDTO struct {
 	FromUsername string
 	CreatedAt    time.Time
 }
+
+// PinnedMessageDTO - закрепленное сообщение и кто его закрепил
+type PinnedMessageDTO struct {
+	Message          MessageDTO
+	PinnedBy         int64
+	PinnedByUsername string
+	PinnedAt         time.Time
+}
+
+// InviteCreateDTO - новое приглашение в чат
+type InviteCreateDTO struct {
+	ChatId    int64
+	CodeHash  []byte // sha256 кода, сам код не хранится
+	CodeHint  string
+	CreatedBy int64
+	MaxUses   int32 // 0 - без ограничения
+	ExpiresAt *time.Time
+}
+
+// InviteDTO - приглашение в чат
+type InviteDTO struct {
+	Id        int64
+	ChatId    int64
+	CodeHint  string
+	CreatedBy int64
+	MaxUses   int32
+	Uses      int32
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// InvitePreviewDTO - чат, в который ведет приглашение
+type InvitePreviewDTO struct {
+	ChatId      int64
+	Name        string
+	MemberCount int32
+	IsMember    bool
+}
+
+// JoinRequestDTO - заявка на вступление в чат
+type JoinRequestDTO struct {
+	Id        int64
+	ChatId    int64
+	UserId    int64
+	Username  string
+	Status    string // pending, approved, rejected
+	CreatedAt time.Time
+}
+
+// SearchFilterDTO - параметры полнотекстового поиска
+type SearchFilterDTO struct {
+	UserId       int64 // Ищем только в чатах, где состоит пользователь
+	Query        string
+	ChatId       int64  // 0 - во всех чатах
+	FromUsername string // Пустая строка - от любого автора
+	MessageType  string // Тип в БД, пустая строка - любой
+	DateFrom     *time.Time
+	DateTo       *time.Time
+	BeforeId     int64 // Курсор: результаты старше этого сообщения
+	Limit        int
+}
+
+// SearchResultDTO - найденное сообщение с названием чата и подсвеченным фрагментом
+type SearchResultDTO struct {
+	Message  MessageDTO
+	ChatName string
+	Snippet  string
+}
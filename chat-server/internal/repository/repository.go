@@ -5,27 +5,101 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrNotFound возвращается, когда запрошенная запись не существует
+var ErrNotFound = errors.New("not found")
+
+// notExpired условие, что сообщение m еще не истекло. Истекшие сообщения не отдаются даже до удаления фоновой задачей
+const notExpired = "(m.expires_at IS NULL OR m.expires_at > NOW())"
+
+// messageColumns колонки сообщения в порядке сканирования scanMessage.
+// m - само сообщение, rm - сообщение, на которое отвечают (для превью цитаты), истекшая цитата отдается как удаленная,
+// lp - превью ссылки из сообщения
+var messageColumns = []string{
+	"m.id", "m.chat_id", "m.user_id", "m.from_username", "m.message_type", "m.text", "m.voice_duration", "m.file_url", "m.file_name", "m.file_size", "m.created_at", "m.edited_at", "m.deleted_at",
+	"m.reply_to_id", "COALESCE(rm.from_username, '')", "COALESCE(LEFT(rm.text, 100), '')", "COALESCE(rm.message_type, '')", "(rm.id IS NULL OR rm.deleted_at IS NOT NULL)",
+	"m.thread_root_id", "m.thread_reply_count", "m.thread_last_reply_at",
+	"m.forwarded_from_username", "m.forwarded_from_chat_id", "m.forwarded_from_message_id",
+	"m.expires_at", "m.seq",
+	"lp.url", "COALESCE(lp.title, '')", "COALESCE(lp.description, '')", "COALESCE(lp.image_url, '')", "COALESCE(lp.site_name, '')",
+}
+
+// selectMessages начало запроса неистекших сообщений вместе с превью цитаты
+func selectMessages() squirrel.SelectBuilder {
+	return squirrel.Select(messageColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("messages m").
+		LeftJoin("messages rm ON rm.id = m.reply_to_id AND (rm.expires_at IS NULL OR rm.expires_at > NOW())").
+		LeftJoin("link_previews lp ON lp.message_id = m.id").
+		Where(notExpired)
+}
+
 type ChatRepository interface {
 	Create(ctx context.Context, dto CreateChatDTO) (int64, error)
-	Delete(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, msg MessageCreateDTO) error
+	Delete(ctx context.Context, id int64, purgeAt time.Time) error
+	RestoreChat(ctx context.Context, id int64) error
+	DeletedChat(ctx context.Context, id int64) (*ChatInfoDTO, error)
+	PurgeDeletedChats(ctx context.Context, limit int) ([]PurgedChatDTO, error)
+	SendMessage(ctx context.Context, msg MessageCreateDTO) (SentMessageDTO, error)
+	MessageById(ctx context.Context, id int64) (*MessageDTO, error)
+	MessagesByIds(ctx context.Context, chatId int64, ids []int64, userId int64) ([]MessageDTO, error)
+	EditMessage(ctx context.Context, id int64, editorId int64, text string) (time.Time, error)
+	DeleteMessageForEveryone(ctx context.Context, id int64) error
+	HideMessage(ctx context.Context, id int64, userId int64) error
+	AddReaction(ctx context.Context, messageId int64, userId int64, emoji string) (bool, error)
+	RemoveReaction(ctx context.Context, messageId int64, userId int64, emoji string) (bool, error)
+	ReactionCount(ctx context.Context, messageId int64, emoji string) (int32, error)
+	ReactionSummaries(ctx context.Context, messageIds []int64, userId int64) (map[int64][]ReactionDTO, error)
 	ChatExists(ctx context.Context, id int64) (bool, error)
 	IsUserInChat(ctx context.Context, chatId, userId int64) (bool, error)
-	RecentMessages(ctx context.Context, chatId int64, limit int) ([]MessageDTO, error)
+	RecentMessages(ctx context.Context, chatId int64, userId int64, limit int) ([]MessageDTO, error)
+	History(ctx context.Context, chatId int64, userId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error)
+	ThreadHistory(ctx context.Context, rootId int64, userId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error)
+	MessagesBySeq(ctx context.Context, chatId int64, userId int64, fromSeq int64, toSeq int64) ([]MessageDTO, error)
+	HistoryAround(ctx context.Context, chatId int64, userId int64, aroundId int64, limit int) ([]MessageDTO, bool, bool, error)
+	SearchMessages(ctx context.Context, filter SearchFilterDTO) ([]SearchResultDTO, bool, error)
 	UserChats(ctx context.Context, userId int64) ([]ChatInfoDTO, error)
 	FindDirectChat(ctx context.Context, userId1 int64, userId2 int64) (int64, error)
 	CreateDirectChat(ctx context.Context, userId1 int64, userId2 int64, username1 string, username2 string) (int64, error)
 	AddMember(ctx context.Context, chatId int64, userId int64, username string) error
 	RemoveMember(ctx context.Context, chatId int64, userId int64) error
-	RemoveMemberByUsername(ctx context.Context, chatId int64, username string) error
+	LeaveChat(ctx context.Context, chatId int64, userId int64) (LeaveResultDTO, error)
 	ChatInfo(ctx context.Context, chatId int64) (*ChatInfoDTO, error)
 	PublicChats(ctx context.Context, search string) ([]PublicChatDTO, error)
 	LastMessages(ctx context.Context, chatIds []int64) (map[int64]LastMessageDTO, error)
+	MemberRole(ctx context.Context, chatId int64, userId int64) (string, error)
+	SetMemberRole(ctx context.Context, chatId int64, userId int64, fromRole string, toRole string) error
+	TransferOwnership(ctx context.Context, chatId int64, ownerId int64, newOwnerId int64) error
+	PinMessage(ctx context.Context, chatId int64, messageId int64, userId int64, username string) (bool, error)
+	UnpinMessage(ctx context.Context, chatId int64, messageId int64) (bool, error)
+	PinnedMessages(ctx context.Context, chatId int64) ([]PinnedMessageDTO, error)
+	TopPins(ctx context.Context, chatIds []int64) (map[int64]ReplyPreviewDTO, error)
+	SaveMentions(ctx context.Context, chatId int64, messageId int64, authorId int64, usernames []string) ([]int64, error)
+	MessageMentions(ctx context.Context, messageId int64) ([]int64, error)
+	SetMessageTTL(ctx context.Context, chatId int64, ttlSeconds int32) error
+	PurgeExpiredMessages(ctx context.Context, limit int) ([]ExpiredMessageDTO, error)
+	FileUrlsInUse(ctx context.Context, urls []string) (map[string]bool, error)
+	Polls(ctx context.Context, messageIds []int64, userId int64) (map[int64]PollDTO, error)
+	VotePoll(ctx context.Context, messageId int64, userId int64, optionIds []int64) (bool, error)
+	ClosePoll(ctx context.Context, messageId int64) (bool, error)
+	SaveLinkPreview(ctx context.Context, messageId int64, preview LinkPreviewDTO) error
+	CreateInvite(ctx context.Context, invite InviteCreateDTO) (InviteDTO, error)
+	InviteById(ctx context.Context, id int64) (*InviteDTO, error)
+	ChatInvites(ctx context.Context, chatId int64) ([]InviteDTO, error)
+	RevokeInvite(ctx context.Context, id int64) error
+	InvitePreview(ctx context.Context, codeHash []byte, userId int64) (InvitePreviewDTO, error)
+	UseInvite(ctx context.Context, codeHash []byte, userId int64, username string) (int64, bool, error)
+	CreateJoinRequest(ctx context.Context, chatId int64, userId int64, username string) (JoinRequestDTO, error)
+	JoinRequestById(ctx context.Context, id int64) (*JoinRequestDTO, error)
+	PendingJoinRequests(ctx context.Context, chatId int64) ([]JoinRequestDTO, error)
+	ResolveJoinRequest(ctx context.Context, id int64, decidedBy int64, approve bool) (JoinRequestDTO, error)
 }
 
 type repo struct {
@@ -43,8 +117,8 @@ func (r *repo) Create(ctx context.Context, dto CreateChatDTO) (int64, error) {
 	// Здесь используем простой запрос
 	chatBuilder := squirrel.Insert("chats").
 		PlaceholderFormat(squirrel.Dollar).
-		Columns("name", "is_direct", "is_public", "creator_id").
-		Values(dto.Name, !dto.IsGroup, dto.IsPublic, dto.CreatorId).
+		Columns("name", "is_direct", "is_public", "creator_id", "join_approval").
+		Values(dto.Name, !dto.IsGroup, dto.IsPublic, dto.CreatorId, dto.JoinApproval).
 		Suffix("RETURNING id")
 
 	chatQuery, args, err := chatBuilder.ToSql()
@@ -82,10 +156,14 @@ func (r *repo) Create(ctx context.Context, dto CreateChatDTO) (int64, error) {
 	return chatId, nil
 }
 
-func (r *repo) Delete(ctx context.Context, chat_id int64) error {
-	builder := squirrel.Delete("chats").
+// Delete мягко удаляет чат: он пропадает у всех участников, а из БД удаляется PurgeDeletedChats после purgeAt.
+// ErrNotFound если чата нет или он уже удален
+func (r *repo) Delete(ctx context.Context, chat_id int64, purgeAt time.Time) error {
+	builder := squirrel.Update("chats").
 		PlaceholderFormat(squirrel.Dollar).
-		Where(squirrel.Eq{"id": chat_id})
+		Set("deleted_at", squirrel.Expr("NOW()")).
+		Set("purge_at", purgeAt).
+		Where(squirrel.Eq{"id": chat_id, "deleted_at": nil})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -98,360 +176,721 @@ func (r *repo) Delete(ctx context.Context, chat_id int64) error {
 	}
 
 	if res.RowsAffected() == 0 {
-		return fmt.Errorf("chat not found")
+		return ErrNotFound
 	}
 
 	return nil
 }
 
-func (r *repo) SendMessage(ctx context.Context, msg MessageCreateDTO) error {
-	// Выполняем основной запрос, добавляем в таблицу messages
-	builder := squirrel.Insert("messages").
-		PlaceholderFormat(squirrel.Dollar).
-		Columns("chat_id", "user_id", "from_username", "text", "message_type", "voice_duration", "file_url", "file_name", "file_size").
-		Values(msg.ChatId, msg.UserId, msg.FromUsername, msg.Text, msg.MessageType, msg.VoiceDuration, msg.FileUrl, msg.FileName, msg.FileSize)
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return fmt.Errorf("build send message query: %w", err)
-	}
-
-	_, err = r.db.Exec(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("insert message: %w", err)
-	}
+// RestoreChat возвращает удаленный чат, пока не наступил purge_at. ErrNotFound если восстанавливать нечего
+func (r *repo) RestoreChat(ctx context.Context, id int64) error {
+	query := "UPDATE chats SET deleted_at = NULL, purge_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL AND purge_at > NOW()"
 
-	// Обновляем updated_at в чате после отправки сообщения
-	updateBuilder := squirrel.Update("chats").PlaceholderFormat(squirrel.Dollar).Set("updated_at", squirrel.Expr("NOW()")).Where(squirrel.Eq{"ID": msg.ChatId})
-	updateQuery, updateArgs, err := updateBuilder.ToSql()
+	res, err := r.db.Exec(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("build update chat query: %w", err)
+		return fmt.Errorf("restore chat: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, updateQuery, updateArgs...)
-	if err != nil {
-		return fmt.Errorf("update chat: %w", err)
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
 	}
 
 	return nil
 }
 
-func (r *repo) RecentMessages(ctx context.Context, chatID int64, limit int) ([]MessageDTO, error) {
-	builder := squirrel.Select("id", "chat_id", "user_id", "from_username", "message_type", "text", "voice_duration", "file_url", "file_name", "file_size", "created_at").
-		PlaceholderFormat(squirrel.Dollar).
-		From("messages").
-		Where(squirrel.Eq{"chat_id": chatID}).
-		OrderBy("created_at ASC").
-		Limit(uint64(limit))
+// DeletedChat возвращает удаленный, но еще не стертый чат вместе с участниками, ErrNotFound если такого нет
+func (r *repo) DeletedChat(ctx context.Context, id int64) (*ChatInfoDTO, error) {
+	query := `
+		SELECT id, name, is_direct, is_public, creator_id, created_at, updated_at, message_ttl_seconds, purge_at
+		FROM chats
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
 
-	query, args, err := builder.ToSql()
+	var chat ChatInfoDTO
+	err := r.db.QueryRow(ctx, query, id).Scan(&chat.Id, &chat.Name, &chat.IsDirect, &chat.IsPublic, &chat.CreatorId, &chat.CreatedAt, &chat.UpdatedAt, &chat.MessageTTLSeconds, &chat.PurgeAt)
 	if err != nil {
-		return nil, fmt.Errorf("build recent messages query: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get deleted chat: %w", err)
 	}
 
-	var messages []MessageDTO
-	rows, err := r.db.Query(ctx, query, args...)
+	members, err := r.chatMembers(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("query messages: %w", err)
+		return nil, fmt.Errorf("get chat members: %w", err)
+	}
+	chat.Members = members
+
+	return &chat, nil
+}
+
+// PurgeDeletedChats окончательно удаляет до limit чатов, у которых истекло окно восстановления.
+// Сообщения, участники и остальные данные чата удаляются каскадом, файлы сообщений возвращаются для удаления из хранилища.
+// SKIP LOCKED позволяет нескольким репликам чистить параллельно, не мешая друг другу
+func (r *repo) PurgeDeletedChats(ctx context.Context, limit int) ([]PurgedChatDTO, error) {
+	query := `
+		WITH due AS (
+			SELECT id FROM chats
+			WHERE deleted_at IS NOT NULL AND purge_at <= NOW()
+			ORDER BY purge_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), files AS (
+			SELECT chat_id, array_agg(DISTINCT file_url) AS urls
+			FROM messages
+			WHERE chat_id IN (SELECT id FROM due) AND file_url <> ''
+			GROUP BY chat_id
+		), purged AS (
+			DELETE FROM chats WHERE id IN (SELECT id FROM due)
+			RETURNING id
+		)
+		SELECT p.id, COALESCE(f.urls, '{}')
+		FROM purged p
+		LEFT JOIN files f ON f.chat_id = p.id
+	`
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("purge deleted chats: %w", err)
 	}
+	defer rows.Close()
 
+	var purged []PurgedChatDTO
 	for rows.Next() {
-		var message MessageDTO
-		err := rows.Scan(&message.Id, &message.ChatId, &message.UserId, &message.From, &message.MessageType, &message.Text, &message.VoiceDuration, &message.FileUrl, &message.FileName, &message.FileSize, &message.CreatedAt)
-		if err != nil {
-			return nil, fmt.Errorf("scan message: %w", err)
+		var chat PurgedChatDTO
+		if err := rows.Scan(&chat.Id, &chat.FileUrls); err != nil {
+			return nil, fmt.Errorf("scan purged chat: %w", err)
 		}
+		purged = append(purged, chat)
+	}
 
-		messages = append(messages, message)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate purged chats: %w", err)
 	}
 
-	return messages, nil
+	return purged, nil
 }
 
-func (r *repo) ChatExists(ctx context.Context, id int64) (bool, error) {
-	var exists bool
-	// Здесь используем простой запрос
-	query := "SELECT EXISTS (SELECT 1 FROM chats WHERE id = $1)"
-	err := r.db.QueryRow(ctx, query, id).Scan(&exists)
-	if err != nil {
-		return false, fmt.Errorf("check chat exists: %w", err)
+// SendMessage сохраняет сообщение и выдает ему следующий номер в чате.
+// Номер берется из счетчика чата под блокировкой строки чата, а счетчик сдвигается тем же запросом и только если
+// сообщение вставлено: номера идут в порядке сохранения и без пропусков, повтор с тем же ключом номер не занимает
+func (r *repo) SendMessage(ctx context.Context, msg MessageCreateDTO) (SentMessageDTO, error) {
+	// Повтор с тем же ключом не должен занимать номер в чате, поэтому сначала ищем уже сохраненное сообщение
+	if msg.ClientKey != nil {
+		sent, err := r.messageByClientKey(ctx, msg.ChatId, msg.UserId, *msg.ClientKey)
+		if err == nil || !errors.Is(err, ErrNotFound) {
+			return sent, err
+		}
 	}
-	return exists, nil
-}
 
-// Проверяем, что пользователь является участником чата
-func (r *repo) IsUserInChat(ctx context.Context, chatId, userId int64) (bool, error) {
-	query := "SELECT EXISTS (SELECT 1 FROM chat_members WHERE chat_id = $1 AND user_id = $2)"
+	// Выполняем основной запрос, добавляем в таблицу messages
+	columns := []string{"chat_id", "user_id", "from_username", "text", "message_type", "voice_duration", "file_url", "file_name", "file_size", "reply_to_id", "thread_root_id", "seq"}
+	values := []any{msg.ChatId, msg.UserId, msg.FromUsername, msg.Text, msg.MessageType, msg.VoiceDuration, msg.FileUrl, msg.FileName, msg.FileSize, msg.ReplyToId, msg.ThreadRootId, squirrel.Expr("(SELECT last_seq + 1 FROM chats WHERE id = ? FOR UPDATE)", msg.ChatId)}
 
-	var isMember bool
-	err := r.db.QueryRow(ctx, query, chatId, userId).Scan(&isMember)
+	// Для пересланного сообщения сохраняем источник
+	if msg.ForwardedFrom != nil {
+		columns = append(columns, "forwarded_from_username", "forwarded_from_chat_id", "forwarded_from_message_id")
+		values = append(values, msg.ForwardedFrom.From, msg.ForwardedFrom.ChatId, msg.ForwardedFrom.MessageId)
+	}
+
+	// Время жизни берется из настроек чата на момент отправки
+	columns = append(columns, "expires_at")
+	values = append(values, squirrel.Expr("(SELECT CASE WHEN message_ttl_seconds > 0 THEN NOW() + make_interval(secs => message_ttl_seconds) END FROM chats WHERE id = ?)", msg.ChatId))
+
+	// Одновременный повтор с тем же ключом упрется в уникальный индекс и ничего не вернет,
+	// тогда счетчик не сдвигается, и отдаем уже сохраненное сообщение
+	suffix := "RETURNING id, created_at, expires_at, seq"
+	if msg.ClientKey != nil {
+		columns = append(columns, "client_key")
+		values = append(values, *msg.ClientKey)
+		suffix = "ON CONFLICT (chat_id, user_id, client_key) WHERE client_key IS NOT NULL DO NOTHING " + suffix
+	}
+
+	insertQuery, insertArgs, err := squirrel.Insert("messages").
+		Columns(columns...).
+		Values(values...).
+		Suffix(suffix).
+		ToSql()
 	if err != nil {
-		return false, fmt.Errorf("check chat membership: %w", err)
+		return SentMessageDTO{}, fmt.Errorf("build send message query: %w", err)
 	}
 
-	return isMember, nil
-}
+	// Счетчик и updated_at чата обновляем тем же запросом, если сообщение вставлено
+	query := `
+		WITH m AS (` + insertQuery + `),
+		c AS (
+			UPDATE chats SET last_seq = m.seq, updated_at = NOW() FROM m WHERE chats.id = ?
+		)`
+	args := append(insertArgs, msg.ChatId)
+
+	// Опрос и его варианты вставляем тем же запросом, чтобы не осталось сообщения-опроса без вариантов
+	if msg.Poll != nil {
+		query += `,
+		p AS (
+			INSERT INTO polls (message_id, multiple_choice, anonymous, closes_at)
+			SELECT id, ?::boolean, ?::boolean, ?::timestamp FROM m
+		),
+		o AS (
+			INSERT INTO poll_options (message_id, position, text)
+			SELECT m.id, u.position, u.text FROM m, unnest(?::text[]) WITH ORDINALITY AS u(text, position)
+		)`
+		args = append(args, msg.Poll.MultipleChoice, msg.Poll.Anonymous, msg.Poll.ClosesAt, msg.Poll.Options)
+	}
 
-func (r *repo) UserChats(ctx context.Context, userId int64) ([]ChatInfoDTO, error) {
-	builder := squirrel.Select("c.ID", "c.name", "c.is_direct", "c.is_public", "c.creator_id", "c.created_at", "c.updated_at").
-		PlaceholderFormat(squirrel.Dollar).
-		From("chats c").
-		Join("chat_members ON c.ID = chat_members.chat_id ").
-		Where(squirrel.Eq{"chat_members.user_id": userId}).
-		OrderBy("c.updated_at DESC")
+	query += `
+		SELECT id, created_at, expires_at, seq FROM m`
 
-	query, args, err := builder.ToSql()
+	query, err = squirrel.Dollar.ReplacePlaceholders(query)
 	if err != nil {
-		return nil, fmt.Errorf("build query: %w", err)
+		return SentMessageDTO{}, fmt.Errorf("build send message query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	var sent SentMessageDTO
+	err = r.db.QueryRow(ctx, query, args...).Scan(&sent.Id, &sent.CreatedAt, &sent.ExpiresAt, &sent.Seq)
 	if err != nil {
-		return nil, fmt.Errorf("query user chats: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) && msg.ClientKey != nil {
+			return r.messageByClientKey(ctx, msg.ChatId, msg.UserId, *msg.ClientKey)
+		}
+		return SentMessageDTO{}, fmt.Errorf("insert message: %w", err)
 	}
 
-	var chats []ChatInfoDTO
-	for rows.Next() {
-		var chat ChatInfoDTO
-		err := rows.Scan(&chat.Id, &chat.Name, &chat.IsDirect, &chat.IsPublic, &chat.CreatorId, &chat.CreatedAt, &chat.UpdatedAt)
+	// Ответ в треде обновляет счетчик и время последнего ответа у корня
+	if msg.ThreadRootId != nil {
+		threadBuilder := squirrel.Update("messages").
+			PlaceholderFormat(squirrel.Dollar).
+			Set("thread_reply_count", squirrel.Expr("thread_reply_count + 1")).
+			Set("thread_last_reply_at", squirrel.Expr("NOW()")).
+			Where(squirrel.Eq{"id": *msg.ThreadRootId})
+
+		threadQuery, threadArgs, err := threadBuilder.ToSql()
 		if err != nil {
-			return nil, fmt.Errorf("scan chat: %w", err)
+			return SentMessageDTO{}, fmt.Errorf("build update thread root query: %w", err)
 		}
 
-		chats = append(chats, chat)
-	}
-
-	for i := range chats {
-		members, err := r.chatMembers(ctx, chats[i].Id)
+		_, err = r.db.Exec(ctx, threadQuery, threadArgs...)
 		if err != nil {
-			return nil, fmt.Errorf("get chat members: %w", err)
+			return SentMessageDTO{}, fmt.Errorf("update thread root: %w", err)
 		}
-		chats[i].Members = members
 	}
 
-	return chats, nil
+	return sent, nil
 }
 
-// Получаем участников чата
-func (r *repo) chatMembers(ctx context.Context, chatID int64) ([]MemberDTO, error) {
-	builder := squirrel.Select("user_id", "username").
+// messageByClientKey возвращает сообщение, уже сохраненное автором в чате с этим ключом идемпотентности.
+// Счетчики треда и время обновления чата при повторе не трогаем
+func (r *repo) messageByClientKey(ctx context.Context, chatId int64, userId int64, clientKey string) (SentMessageDTO, error) {
+	builder := squirrel.Select("id", "created_at", "expires_at", "seq").
 		PlaceholderFormat(squirrel.Dollar).
-		From("chat_members").
-		Where(squirrel.Eq{"chat_id": chatID}).
-		OrderBy("joined_at ASC")
+		From("messages").
+		Where(squirrel.Eq{"chat_id": chatId, "user_id": userId, "client_key": clientKey})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("build query: %w", err)
+		return SentMessageDTO{}, fmt.Errorf("build message by client key query: %w", err)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	sent := SentMessageDTO{Duplicate: true}
+	err = r.db.QueryRow(ctx, query, args...).Scan(&sent.Id, &sent.CreatedAt, &sent.ExpiresAt, &sent.Seq)
 	if err != nil {
-		return nil, fmt.Errorf("query chat members: %w", err)
-	}
-	defer rows.Close()
-
-	var members []MemberDTO
-	for rows.Next() {
-		var member MemberDTO
-		err := rows.Scan(&member.UserId, &member.Username)
-		if err != nil {
-			return nil, fmt.Errorf("scan member: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SentMessageDTO{}, ErrNotFound
 		}
-		members = append(members, member)
+		return SentMessageDTO{}, fmt.Errorf("get message by client key: %w", err)
 	}
 
-	return members, nil
+	return sent, nil
 }
 
-// FindDirectChat - находим личный чат между двумя пользователями
-func (r *repo) FindDirectChat(ctx context.Context, userId1 int64, userId2 int64) (int64, error) {
-	// Личный чат — это чат ровно с 2 участниками
-	builder := squirrel.Select("cm1.chat_id").
-		PlaceholderFormat(squirrel.Dollar).
-		From("chat_members cm1").
-		Join("chats on chats.id = cm1.chat_id").
-		Join("chat_members cm2 on cm1.chat_id = cm2.chat_id").
-		Where(squirrel.And{
-			squirrel.Eq{"chats.is_direct": true},
-			squirrel.Eq{"cm1.user_id": userId1},
-			squirrel.Eq{"cm2.user_id": userId2},
-		}).
+// MessageById получаем сообщение по его id
+func (r *repo) MessageById(ctx context.Context, id int64) (*MessageDTO, error) {
+	builder := selectMessages().
+		Where(squirrel.Eq{"m.id": id}).
 		Limit(1)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return 0, fmt.Errorf("build query: %w", err)
+		return nil, fmt.Errorf("build query: %w", err)
 	}
 
-	var chatId int64
-	err = r.db.QueryRow(ctx, query, args...).Scan(&chatId)
+	var message MessageDTO
+	err = scanMessage(r.db.QueryRow(ctx, query, args...), &message)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return 0, nil
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
 		}
-		return 0, fmt.Errorf("query direct chat: %w", err)
+		return nil, fmt.Errorf("get message: %w", err)
 	}
 
-	return chatId, nil
+	return &message, nil
 }
 
-// CreateDirectChat - создаем личный чат между двумя пользователями
-func (r *repo) CreateDirectChat(ctx context.Context, userId1 int64, userId2 int64, username1 string, username2 string) (int64, error) {
-	// Сначала создаем сам чат
-	builder := squirrel.Insert("chats").
-		PlaceholderFormat(squirrel.Dollar).
-		Columns("name", "is_direct", "is_public", "creator_id").
-		Values("", true, false, userId1).
-		Suffix("RETURNING id")
+// MessagesByIds возвращает существующие и не удаленные сообщения чата из списка в хронологическом порядке.
+// Скрытые пользователем сообщения не возвращаются
+func (r *repo) MessagesByIds(ctx context.Context, chatId int64, ids []int64, userId int64) ([]MessageDTO, error) {
+	builder := selectMessages().
+		Where(squirrel.Eq{"m.chat_id": chatId, "m.id": ids, "m.deleted_at": nil}).
+		Where("NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.message_id = m.id AND h.user_id = ?)", userId).
+		OrderBy("m.created_at", "m.id")
 
-	chatQuery, args, err := builder.ToSql()
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return 0, fmt.Errorf("build query: %w", err)
+		return nil, fmt.Errorf("build query: %w", err)
 	}
 
-	var chatId int64
-	err = r.db.QueryRow(ctx, chatQuery, args...).Scan(&chatId)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return 0, fmt.Errorf("create direct chat: %w", err)
+		return nil, fmt.Errorf("query messages: %w", err)
 	}
+	defer rows.Close()
 
-	// Теперь добавляем обоих участников в таблицу chat_members
-	membersBuilder := squirrel.Insert("chat_members").
-		PlaceholderFormat(squirrel.Dollar).
-		Columns("chat_id", "user_id", "username", "role").
-		Values(chatId, userId1, username1, "member").
-		Values(chatId, userId2, username2, "member")
+	var messages []MessageDTO
+	for rows.Next() {
+		var message MessageDTO
+		err := scanMessage(rows, &message)
+		if err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
 
-	membersQuery, args, err := membersBuilder.ToSql()
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// EditMessage сохраняем текущий текст в message_revisions, сбрасываем превью ссылки и обновляем сообщение одним запросом
+func (r *repo) EditMessage(ctx context.Context, id int64, editorId int64, text string) (time.Time, error) {
+	query := `
+		WITH old AS (
+			SELECT id, text FROM messages WHERE id = $1 FOR UPDATE
+		), revision AS (
+			INSERT INTO message_revisions (message_id, text, edited_by)
+			SELECT id, text, $3 FROM old
+		), preview AS (
+			DELETE FROM link_previews WHERE message_id IN (SELECT id FROM old)
+		)
+		UPDATE messages SET text = $2, edited_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		RETURNING edited_at
+	`
+
+	var editedAt time.Time
+	err := r.db.QueryRow(ctx, query, id, text, editorId).Scan(&editedAt)
 	if err != nil {
-		return 0, fmt.Errorf("build query: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, ErrNotFound
+		}
+		return time.Time{}, fmt.Errorf("edit message: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, membersQuery, args...)
+	return editedAt, nil
+}
+
+// DeleteMessageForEveryone превращает сообщение в заглушку: содержимое, история правок, реакции и превью ссылки удаляются, строка остается для порядка
+func (r *repo) DeleteMessageForEveryone(ctx context.Context, id int64) error {
+	query := `
+		WITH revisions AS (
+			DELETE FROM message_revisions WHERE message_id = $1
+		), reactions AS (
+			DELETE FROM message_reactions WHERE message_id = $1
+		), pins AS (
+			DELETE FROM pinned_messages WHERE message_id = $1
+		), previews AS (
+			DELETE FROM link_previews WHERE message_id = $1
+		)
+		UPDATE messages
+		SET text = '', file_url = '', file_name = '', file_size = 0, voice_duration = 0, deleted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	res, err := r.db.Exec(ctx, query, id)
 	if err != nil {
-		return 0, fmt.Errorf("add members to direct chat: %w", err)
+		return fmt.Errorf("delete message: %w", err)
 	}
 
-	return chatId, nil
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
 }
 
-func (r *repo) AddMember(ctx context.Context, chatId int64, userId int64, username string) error {
-	builder := squirrel.Insert("chat_members").
+// HideMessage скрывает сообщение только для одного пользователя
+func (r *repo) HideMessage(ctx context.Context, id int64, userId int64) error {
+	builder := squirrel.Insert("message_hidden").
 		PlaceholderFormat(squirrel.Dollar).
-		Columns("chat_id", "user_id", "username", "role").
-		Values(chatId, userId, username, "member")
+		Columns("message_id", "user_id").
+		Values(id, userId).
+		Suffix("ON CONFLICT (message_id, user_id) DO NOTHING")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return fmt.Errorf("build query: %w", err)
+		return fmt.Errorf("build hide message query: %w", err)
 	}
 
 	_, err = r.db.Exec(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("add member to chat: %w", err)
+		return fmt.Errorf("hide message: %w", err)
 	}
 
 	return nil
 }
 
-func (r *repo) RemoveMember(ctx context.Context, chatId int64, userId int64) error {
-	builder := squirrel.Delete("chat_members").
-		PlaceholderFormat(squirrel.Dollar).
-		Where(squirrel.And{
-			squirrel.Eq{"chat_id": chatId},
-			squirrel.Eq{"user_id": userId},
-		})
+// RecentMessages возвращает последние limit сообщений чата в хронологическом порядке
+func (r *repo) RecentMessages(ctx context.Context, chatID int64, userId int64, limit int) ([]MessageDTO, error) {
+	messages, _, err := r.History(ctx, chatID, userId, 0, 0, limit)
+	return messages, err
+}
+
+// History возвращает страницу сообщений чата по курсору (created_at, id) в хронологическом порядке.
+// beforeId - сообщения старше указанного, afterId - новее, без курсора - самые новые.
+// Скрытые пользователем сообщения пропускаются, удаленные для всех приходят заглушками.
+// Ответы в тредах в ленту чата не попадают.
+func (r *repo) History(ctx context.Context, chatID int64, userId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error) {
+	builder := selectMessages().
+		Where(squirrel.Eq{"m.chat_id": chatID, "m.thread_root_id": nil})
+
+	return r.messagesPage(ctx, builder, userId, beforeId, afterId, limit)
+}
+
+// ThreadHistory возвращает страницу ответов в треде, курсоры работают так же как в History
+func (r *repo) ThreadHistory(ctx context.Context, rootId int64, userId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error) {
+	builder := selectMessages().
+		Where(squirrel.Eq{"m.thread_root_id": rootId})
+
+	return r.messagesPage(ctx, builder, userId, beforeId, afterId, limit)
+}
+
+// MessagesBySeq возвращает сообщения чата с порядковыми номерами от fromSeq до toSeq включительно по возрастанию номера.
+// В отличие от History отдает и ответы в тредах, потому что номера у ленты и тредов общие
+func (r *repo) MessagesBySeq(ctx context.Context, chatID int64, userId int64, fromSeq int64, toSeq int64) ([]MessageDTO, error) {
+	builder := selectMessages().
+		Where(squirrel.Eq{"m.chat_id": chatID}).
+		Where("m.seq BETWEEN ? AND ?", fromSeq, toSeq).
+		Where("NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.message_id = m.id AND h.user_id = ?)", userId).
+		OrderBy("m.seq")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return fmt.Errorf("build query: %w", err)
+		return nil, fmt.Errorf("build messages by seq query: %w", err)
 	}
 
-	res, err := r.db.Exec(ctx, query, args...)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("remove member from chat: %w", err)
+		return nil, fmt.Errorf("get messages by seq: %w", err)
 	}
+	defer rows.Close()
 
-	if res.RowsAffected() == 0 {
-		return fmt.Errorf("member not found")
+	var messages []MessageDTO
+	for rows.Next() {
+		var message MessageDTO
+		if err := scanMessage(rows, &message); err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
+		messages = append(messages, message)
 	}
 
-	return nil
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate messages: %w", err)
+	}
+
+	return messages, nil
 }
 
-func (r *repo) RemoveMemberByUsername(ctx context.Context, chatID int64, username string) error {
-	builder := squirrel.Delete("chat_members").
-		PlaceholderFormat(squirrel.Dollar).
-		Where(squirrel.And{
-			squirrel.Eq{"chat_id": chatID},
-			squirrel.Eq{"username": username},
-		})
+// HistoryAround возвращает страницу ленты чата вокруг сообщения aroundId (включая его) от старых к новым
+// и флаги, есть ли сообщения старше и новее страницы. Используется для перехода к результату поиска
+func (r *repo) HistoryAround(ctx context.Context, chatID int64, userId int64, aroundId int64, limit int) ([]MessageDTO, bool, bool, error) {
+	feed := selectMessages().
+		Where(squirrel.Eq{"m.chat_id": chatID, "m.thread_root_id": nil})
 
-	query, args, err := builder.ToSql()
+	target, _, err := r.messagesPage(ctx, feed.Where(squirrel.Eq{"m.id": aroundId}), userId, 0, 0, 1)
 	if err != nil {
-		return fmt.Errorf("build query: %w", err)
+		return nil, false, false, err
+	}
+	if len(target) == 0 {
+		return nil, false, false, ErrNotFound
 	}
 
-	res, err := r.db.Exec(ctx, query, args...)
+	// Половина страницы до сообщения, остальное - после
+	older, hasOlder, err := r.messagesPage(ctx, feed, userId, aroundId, 0, limit/2)
 	if err != nil {
-		return fmt.Errorf("remove member from chat: %w", err)
+		return nil, false, false, err
 	}
 
-	if res.RowsAffected() == 0 {
-		return fmt.Errorf("member not found")
+	newer, hasNewer, err := r.messagesPage(ctx, feed, userId, 0, aroundId, limit-limit/2-1)
+	if err != nil {
+		return nil, false, false, err
 	}
 
-	return nil
+	messages := make([]MessageDTO, 0, len(older)+1+len(newer))
+	messages = append(messages, older...)
+	messages = append(messages, target...)
+	messages = append(messages, newer...)
+
+	return messages, hasOlder, hasNewer, nil
 }
 
-func (r *repo) ChatInfo(ctx context.Context, chatId int64) (*ChatInfoDTO, error) {
-	builder := squirrel.Select("id", "name", "is_direct", "is_public", "creator_id", "created_at", "updated_at").
-		PlaceholderFormat(squirrel.Dollar).
-		From("chats").
-		Where(squirrel.Eq{"id": chatId}).
-		Limit(1)
+// Границы совпадений в ts_headline. Служебные символы вместо тегов, чтобы текст сообщения можно было экранировать
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
 
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("build query: %w", err)
+var headlineOptions = fmt.Sprintf(`StartSel="%s", StopSel="%s", MaxWords=30, MinWords=10, MaxFragments=2`, HighlightStart, HighlightStop)
+
+// SearchMessages ищет сообщения по тексту в чатах, где состоит пользователь, от новых к старым.
+// Курсор - id последнего полученного результата в BeforeId
+func (r *repo) SearchMessages(ctx context.Context, filter SearchFilterDTO) ([]SearchResultDTO, bool, error) {
+	tsQuery := "websearch_to_tsquery('russian', ?)"
+
+	builder := selectMessages().
+		Column("c.name").
+		Column(squirrel.Expr("ts_headline('russian', m.text, "+tsQuery+", ?)", filter.Query, headlineOptions)).
+		Join("chats c ON c.id = m.chat_id AND c.deleted_at IS NULL").
+		Join("chat_members cm ON cm.chat_id = m.chat_id AND cm.user_id = ?", filter.UserId).
+		Where("m.text_search @@ "+tsQuery, filter.Query).
+		Where(squirrel.Eq{"m.deleted_at": nil}).
+		Where("NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.message_id = m.id AND h.user_id = ?)", filter.UserId).
+		OrderBy("m.created_at DESC", "m.id DESC").
+		Limit(uint64(filter.Limit + 1)) // Берем на одно больше, чтобы понять есть ли еще страница
+
+	if filter.ChatId > 0 {
+		builder = builder.Where(squirrel.Eq{"m.chat_id": filter.ChatId})
+	}
+	if filter.FromUsername != "" {
+		builder = builder.Where(squirrel.Eq{"m.from_username": filter.FromUsername})
+	}
+	if filter.MessageType != "" {
+		builder = builder.Where(squirrel.Eq{"m.message_type": filter.MessageType})
+	}
+	if filter.DateFrom != nil {
+		builder = builder.Where(squirrel.GtOrEq{"m.created_at": *filter.DateFrom})
+	}
+	if filter.DateTo != nil {
+		builder = builder.Where(squirrel.Lt{"m.created_at": *filter.DateTo})
+	}
+	if filter.BeforeId > 0 {
+		builder = builder.Where("(m.created_at, m.id) < (SELECT created_at, id FROM messages WHERE id = ?)", filter.BeforeId)
 	}
 
-	var chat ChatInfoDTO
-	err = r.db.QueryRow(ctx, query, args...).Scan(&chat.Id, &chat.Name, &chat.IsDirect, &chat.IsPublic, &chat.CreatorId, &chat.CreatedAt, &chat.UpdatedAt)
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("get chat info: %w", err)
+		return nil, false, fmt.Errorf("build search query: %w", err)
 	}
 
-	members, err := r.chatMembers(ctx, chatId)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("get chat members: %w", err)
+		return nil, false, fmt.Errorf("search messages: %w", err)
 	}
+	defer rows.Close()
 
-	chat.Members = members
+	var results []SearchResultDTO
+	for rows.Next() {
+		var result SearchResultDTO
+		err := scanMessage(rows, &result.Message, &result.ChatName, &result.Snippet)
+		if err != nil {
+			return nil, false, fmt.Errorf("scan search result: %w", err)
+		}
 
-	return &chat, nil
-}
+		results = append(results, result)
+	}
 
-func (r *repo) PublicChats(ctx context.Context, search string) ([]PublicChatDTO, error) {
-	builder := squirrel.Select(
-		"c.id",
-		"c.name",
-		"COUNT(cm.user_id) as member_count",
-		"COALESCE(creator.username, '') as creator_name",
-		"c.created_at",
-	).
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate search results: %w", err)
+	}
+
+	hasMore := len(results) > filter.Limit
+	if hasMore {
+		results = results[:filter.Limit]
+	}
+
+	return results, hasMore, nil
+}
+
+// messagesPage догружает в запрос курсор, сортировку и лимит и возвращает страницу от старых к новым
+func (r *repo) messagesPage(ctx context.Context, builder squirrel.SelectBuilder, userId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error) {
+	builder = builder.
+		Where("NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.message_id = m.id AND h.user_id = ?)", userId).
+		Limit(uint64(limit + 1)) // Берем на одно больше, чтобы понять есть ли еще страница
+
+	// Идем вниз по индексу, кроме загрузки более новых сообщений
+	descending := true
+	switch {
+	case beforeId > 0:
+		builder = builder.
+			Where("(m.created_at, m.id) < (SELECT created_at, id FROM messages WHERE id = ?)", beforeId).
+			OrderBy("m.created_at DESC", "m.id DESC")
+	case afterId > 0:
+		descending = false
+		builder = builder.
+			Where("(m.created_at, m.id) > (SELECT created_at, id FROM messages WHERE id = ?)", afterId).
+			OrderBy("m.created_at ASC", "m.id ASC")
+	default:
+		builder = builder.OrderBy("m.created_at DESC", "m.id DESC")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("build history query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("query messages: %w", err)
+	}
+
+	defer rows.Close()
+
+	var messages []MessageDTO
+	for rows.Next() {
+		var message MessageDTO
+		err := scanMessage(rows, &message)
+		if err != nil {
+			return nil, false, fmt.Errorf("scan message: %w", err)
+		}
+
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("iterate messages: %w", err)
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	// Клиенту всегда отдаем от старых к новым
+	if descending {
+		slices.Reverse(messages)
+	}
+
+	return messages, hasMore, nil
+}
+
+// scanMessage сканирует строку с колонками messageColumns, extra - дополнительные колонки после них
+func scanMessage(row pgx.Row, message *MessageDTO, extra ...any) error {
+	var (
+		replyToId *int64
+		reply     ReplyPreviewDTO
+
+		forwardedFrom      *string
+		forwardedChatId    *int64
+		forwardedMessageId *int64
+
+		previewUrl *string
+		preview    LinkPreviewDTO
+	)
+
+	dest := []any{&message.Id, &message.ChatId, &message.UserId, &message.From, &message.MessageType, &message.Text, &message.VoiceDuration, &message.FileUrl, &message.FileName, &message.FileSize, &message.CreatedAt, &message.EditedAt, &message.DeletedAt,
+		&replyToId, &reply.From, &reply.Text, &reply.MessageType, &reply.Deleted,
+		&message.ThreadRootId, &message.ThreadReplyCount, &message.ThreadLastReplyAt,
+		&forwardedFrom, &forwardedChatId, &forwardedMessageId,
+		&message.ExpiresAt, &message.Seq,
+		&previewUrl, &preview.Title, &preview.Description, &preview.ImageUrl, &preview.SiteName}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return err
+	}
+
+	// Если исходное сообщение удалено из БД, reply_to_id уже обнулен
+	if replyToId != nil {
+		reply.MessageId = *replyToId
+		message.ReplyTo = &reply
+	}
+
+	// Источник пересылки остается, даже если исходное сообщение удалено
+	if forwardedFrom != nil {
+		message.ForwardedFrom = &ForwardedFromDTO{
+			From:      *forwardedFrom,
+			MessageId: forwardedMessageId,
+		}
+		if forwardedChatId != nil {
+			message.ForwardedFrom.ChatId = *forwardedChatId
+		}
+	}
+
+	if previewUrl != nil {
+		preview.Url = *previewUrl
+		message.LinkPreview = &preview
+	}
+
+	return nil
+}
+
+// AddReaction ставит реакцию, возвращает false если такая реакция уже была
+func (r *repo) AddReaction(ctx context.Context, messageId int64, userId int64, emoji string) (bool, error) {
+	builder := squirrel.Insert("message_reactions").
 		PlaceholderFormat(squirrel.Dollar).
-		From("chats c").
-		LeftJoin("chat_members cm ON c.id = cm.chat_id").
-		LeftJoin("chat_members creator ON c.id = creator.chat_id AND creator.role = 'owner'").
-		Where(squirrel.Eq{"c.is_public": true, "c.is_direct": false}).
-		GroupBy("c.id", "creator.username").
-		OrderBy("c.created_at DESC")
+		Columns("message_id", "user_id", "emoji").
+		Values(messageId, userId, emoji).
+		Suffix("ON CONFLICT (message_id, user_id, emoji) DO NOTHING")
 
-	if search != "" {
-		builder = builder.Where(squirrel.ILike{"c.name": "%" + search + "%"})
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build add reaction query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("add reaction: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// RemoveReaction снимает реакцию, возвращает false если реакции не было
+func (r *repo) RemoveReaction(ctx context.Context, messageId int64, userId int64, emoji string) (bool, error) {
+	builder := squirrel.Delete("message_reactions").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"message_id": messageId, "user_id": userId, "emoji": emoji})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build remove reaction query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("remove reaction: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// ReactionCount количество реакций с эмодзи на сообщении
+func (r *repo) ReactionCount(ctx context.Context, messageId int64, emoji string) (int32, error) {
+	query := "SELECT COUNT(*) FROM message_reactions WHERE message_id = $1 AND emoji = $2"
+
+	var count int32
+	err := r.db.QueryRow(ctx, query, messageId, emoji).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count reactions: %w", err)
+	}
+
+	return count, nil
+}
+
+// ReactionSummaries сводка реакций по сообщениям: эмодзи, количество и ставил ли пользователь.
+// Эмодзи идут в порядке первой реакции
+func (r *repo) ReactionSummaries(ctx context.Context, messageIds []int64, userId int64) (map[int64][]ReactionDTO, error) {
+	if len(messageIds) == 0 {
+		return nil, nil
 	}
 
+	builder := squirrel.Select("message_id", "emoji", "COUNT(*)").
+		Column(squirrel.Expr("BOOL_OR(user_id = ?)", userId)).
+		PlaceholderFormat(squirrel.Dollar).
+		From("message_reactions").
+		Where(squirrel.Eq{"message_id": messageIds}).
+		GroupBy("message_id", "emoji").
+		OrderBy("message_id", "MIN(created_at)")
+
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("build query: %w", err)
@@ -459,35 +898,107 @@ func (r *repo) PublicChats(ctx context.Context, search string) ([]PublicChatDTO,
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query public chats: %w", err)
+		return nil, fmt.Errorf("query reactions: %w", err)
 	}
 
 	defer rows.Close()
 
-	var chats []PublicChatDTO
+	result := make(map[int64][]ReactionDTO)
 	for rows.Next() {
-		var chat PublicChatDTO
-		err := rows.Scan(&chat.Id, &chat.Name, &chat.MemberCount, &chat.CreatorName, &chat.CreatedAt)
+		var messageId int64
+		var reaction ReactionDTO
+		err := rows.Scan(&messageId, &reaction.Emoji, &reaction.Count, &reaction.ReactedByMe)
+		if err != nil {
+			return nil, fmt.Errorf("scan reaction: %w", err)
+		}
+
+		result[messageId] = append(result[messageId], reaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reactions: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *repo) ChatExists(ctx context.Context, id int64) (bool, error) {
+	var exists bool
+	// Здесь используем простой запрос
+	query := "SELECT EXISTS (SELECT 1 FROM chats WHERE id = $1 AND deleted_at IS NULL)"
+	err := r.db.QueryRow(ctx, query, id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check chat exists: %w", err)
+	}
+	return exists, nil
+}
+
+// Проверяем, что пользователь является участником чата
+func (r *repo) IsUserInChat(ctx context.Context, chatId, userId int64) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM chat_members cm
+			JOIN chats c ON c.id = cm.chat_id
+			WHERE cm.chat_id = $1 AND cm.user_id = $2 AND c.deleted_at IS NULL
+		)
+	`
+
+	var isMember bool
+	err := r.db.QueryRow(ctx, query, chatId, userId).Scan(&isMember)
+	if err != nil {
+		return false, fmt.Errorf("check chat membership: %w", err)
+	}
+
+	return isMember, nil
+}
+
+func (r *repo) UserChats(ctx context.Context, userId int64) ([]ChatInfoDTO, error) {
+	builder := squirrel.Select("c.ID", "c.name", "c.is_direct", "c.is_public", "c.creator_id", "c.created_at", "c.updated_at", "c.message_ttl_seconds", "c.join_approval").
+		PlaceholderFormat(squirrel.Dollar).
+		From("chats c").
+		Join("chat_members ON c.ID = chat_members.chat_id ").
+		Where(squirrel.Eq{"chat_members.user_id": userId, "c.deleted_at": nil}).
+		OrderBy("c.updated_at DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query user chats: %w", err)
+	}
+
+	var chats []ChatInfoDTO
+	for rows.Next() {
+		var chat ChatInfoDTO
+		err := rows.Scan(&chat.Id, &chat.Name, &chat.IsDirect, &chat.IsPublic, &chat.CreatorId, &chat.CreatedAt, &chat.UpdatedAt, &chat.MessageTTLSeconds, &chat.JoinApproval)
 		if err != nil {
 			return nil, fmt.Errorf("scan chat: %w", err)
 		}
+
 		chats = append(chats, chat)
 	}
 
+	for i := range chats {
+		members, err := r.chatMembers(ctx, chats[i].Id)
+		if err != nil {
+			return nil, fmt.Errorf("get chat members: %w", err)
+		}
+		chats[i].Members = members
+	}
+
 	return chats, nil
 }
 
-func (r *repo) LastMessages(ctx context.Context, chatIds []int64) (map[int64]LastMessageDTO, error) {
-	builder := squirrel.Select(
-		"DISTINCT ON (m.chat_id) m.chat_id",
-		"m.text",
-		"m.from_username",
-		"m.created_at",
-	).
+// Получаем участников чата
+func (r *repo) chatMembers(ctx context.Context, chatID int64) ([]MemberDTO, error) {
+	builder := squirrel.Select("user_id", "username", "role").
 		PlaceholderFormat(squirrel.Dollar).
-		From("messages m").
-		Where(squirrel.Eq{"m.chat_id": chatIds}).
-		OrderBy("m.chat_id", "m.created_at DESC")
+		From("chat_members").
+		Where(squirrel.Eq{"chat_id": chatID}).
+		OrderBy("joined_at ASC")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -496,26 +1007,1084 @@ func (r *repo) LastMessages(ctx context.Context, chatIds []int64) (map[int64]Las
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query last messages: %w", err)
+		return nil, fmt.Errorf("query chat members: %w", err)
 	}
-
 	defer rows.Close()
 
-	result := make(map[int64]LastMessageDTO)
+	var members []MemberDTO
 	for rows.Next() {
-		var msg LastMessageDTO
-		err := rows.Scan(&msg.ChatId, &msg.Text, &msg.FromUsername, &msg.CreatedAt)
+		var member MemberDTO
+		err := rows.Scan(&member.UserId, &member.Username, &member.Role)
 		if err != nil {
-			return nil, fmt.Errorf("scan message: %w", err)
+			return nil, fmt.Errorf("scan member: %w", err)
 		}
+		members = append(members, member)
+	}
 
-		// Обрезаем текст для превью
-		if len(msg.Text) > 100 {
-			msg.Text = msg.Text[:100] + "..."
+	return members, nil
+}
+
+// FindDirectChat - находим личный чат между двумя пользователями
+func (r *repo) FindDirectChat(ctx context.Context, userId1 int64, userId2 int64) (int64, error) {
+	// Личный чат — это чат ровно с 2 участниками
+	builder := squirrel.Select("cm1.chat_id").
+		PlaceholderFormat(squirrel.Dollar).
+		From("chat_members cm1").
+		Join("chats on chats.id = cm1.chat_id").
+		Join("chat_members cm2 on cm1.chat_id = cm2.chat_id").
+		Where(squirrel.And{
+			squirrel.Eq{"chats.is_direct": true},
+			squirrel.Eq{"chats.deleted_at": nil},
+			squirrel.Eq{"cm1.user_id": userId1},
+			squirrel.Eq{"cm2.user_id": userId2},
+		}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	var chatId int64
+	err = r.db.QueryRow(ctx, query, args...).Scan(&chatId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
 		}
+		return 0, fmt.Errorf("query direct chat: %w", err)
+	}
 
-		result[msg.ChatId] = msg
+	return chatId, nil
+}
+
+// CreateDirectChat - создаем личный чат между двумя пользователями
+func (r *repo) CreateDirectChat(ctx context.Context, userId1 int64, userId2 int64, username1 string, username2 string) (int64, error) {
+	// Сначала создаем сам чат
+	builder := squirrel.Insert("chats").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("name", "is_direct", "is_public", "creator_id").
+		Values("", true, false, userId1).
+		Suffix("RETURNING id")
+
+	chatQuery, args, err := builder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
 	}
 
-	return result, nil
+	var chatId int64
+	err = r.db.QueryRow(ctx, chatQuery, args...).Scan(&chatId)
+	if err != nil {
+		return 0, fmt.Errorf("create direct chat: %w", err)
+	}
+
+	// Теперь добавляем обоих участников в таблицу chat_members
+	membersBuilder := squirrel.Insert("chat_members").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("chat_id", "user_id", "username", "role").
+		Values(chatId, userId1, username1, "member").
+		Values(chatId, userId2, username2, "member")
+
+	membersQuery, args, err := membersBuilder.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.db.Exec(ctx, membersQuery, args...)
+	if err != nil {
+		return 0, fmt.Errorf("add members to direct chat: %w", err)
+	}
+
+	return chatId, nil
+}
+
+func (r *repo) AddMember(ctx context.Context, chatId int64, userId int64, username string) error {
+	builder := squirrel.Insert("chat_members").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("chat_id", "user_id", "username", "role").
+		Values(chatId, userId, username, "member")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("add member to chat: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repo) RemoveMember(ctx context.Context, chatId int64, userId int64) error {
+	builder := squirrel.Delete("chat_members").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.And{
+			squirrel.Eq{"chat_id": chatId},
+			squirrel.Eq{"user_id": userId},
+		})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("remove member from chat: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("member not found")
+	}
+
+	return nil
+}
+
+// LeaveChat удаляет участника из чата. Если выходит владелец, чат тем же запросом переходит
+// к старшему по роли участнику, среди равных - к вступившему раньше всех. ErrNotFound если пользователя нет в чате.
+// Строка чата блокируется до удаления, поэтому одновременные выходы идут по очереди и последний увидит Remaining == 0
+func (r *repo) LeaveChat(ctx context.Context, chatId int64, userId int64) (LeaveResultDTO, error) {
+	// Снимок запроса сделан до ожидания блокировки и не видит выход, завершившийся за это время.
+	// Поэтому оставшихся считаем через блокировку их строк: строки уже вышедших при блокировке пропускаются
+	query := `
+		WITH locked AS (
+			SELECT id FROM chats WHERE id = $1 FOR UPDATE
+		), leaving AS (
+			DELETE FROM chat_members WHERE chat_id = (SELECT id FROM locked) AND user_id = $2
+			RETURNING role
+		), others AS (
+			SELECT user_id FROM chat_members
+			WHERE chat_id = (SELECT id FROM locked) AND user_id <> $2
+			FOR KEY SHARE
+		), heir AS (
+			SELECT user_id FROM chat_members
+			WHERE chat_id = $1 AND user_id <> $2 AND EXISTS (SELECT 1 FROM leaving WHERE role = 'owner')
+			ORDER BY CASE role WHEN 'admin' THEN 1 WHEN 'moderator' THEN 2 WHEN 'member' THEN 3 ELSE 4 END, joined_at
+			LIMIT 1
+			FOR UPDATE
+		), promoted AS (
+			UPDATE chat_members SET role = 'owner'
+			WHERE chat_id = $1 AND user_id IN (SELECT user_id FROM heir)
+			RETURNING user_id
+		), creator AS (
+			UPDATE chats SET creator_id = p.user_id, updated_at = NOW()
+			FROM promoted p
+			WHERE chats.id = $1
+		)
+		SELECT
+			(SELECT role FROM leaving),
+			COALESCE((SELECT user_id FROM promoted), 0),
+			(SELECT COUNT(*) FROM others)
+	`
+
+	var role *string
+	var result LeaveResultDTO
+	err := r.db.QueryRow(ctx, query, chatId, userId).Scan(&role, &result.NewOwnerId, &result.Remaining)
+	if err != nil {
+		return LeaveResultDTO{}, fmt.Errorf("leave chat: %w", err)
+	}
+
+	if role == nil {
+		return LeaveResultDTO{}, ErrNotFound
+	}
+	result.Role = *role
+
+	return result, nil
+}
+
+func (r *repo) ChatInfo(ctx context.Context, chatId int64) (*ChatInfoDTO, error) {
+	builder := squirrel.Select("id", "name", "is_direct", "is_public", "creator_id", "created_at", "updated_at", "message_ttl_seconds", "join_approval").
+		PlaceholderFormat(squirrel.Dollar).
+		From("chats").
+		Where(squirrel.Eq{"id": chatId, "deleted_at": nil}).
+		Limit(1)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	var chat ChatInfoDTO
+	err = r.db.QueryRow(ctx, query, args...).Scan(&chat.Id, &chat.Name, &chat.IsDirect, &chat.IsPublic, &chat.CreatorId, &chat.CreatedAt, &chat.UpdatedAt, &chat.MessageTTLSeconds, &chat.JoinApproval)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get chat info: %w", err)
+	}
+
+	members, err := r.chatMembers(ctx, chatId)
+	if err != nil {
+		return nil, fmt.Errorf("get chat members: %w", err)
+	}
+
+	chat.Members = members
+
+	return &chat, nil
+}
+
+func (r *repo) PublicChats(ctx context.Context, search string) ([]PublicChatDTO, error) {
+	builder := squirrel.Select(
+		"c.id",
+		"c.name",
+		"COUNT(cm.user_id) as member_count",
+		"COALESCE(creator.username, '') as creator_name",
+		"c.created_at",
+		"c.join_approval",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("chats c").
+		LeftJoin("chat_members cm ON c.id = cm.chat_id").
+		LeftJoin("chat_members creator ON c.id = creator.chat_id AND creator.role = 'owner'").
+		Where(squirrel.Eq{"c.is_public": true, "c.is_direct": false, "c.deleted_at": nil}).
+		GroupBy("c.id", "creator.username").
+		OrderBy("c.created_at DESC")
+
+	if search != "" {
+		builder = builder.Where(squirrel.ILike{"c.name": "%" + search + "%"})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query public chats: %w", err)
+	}
+
+	defer rows.Close()
+
+	var chats []PublicChatDTO
+	for rows.Next() {
+		var chat PublicChatDTO
+		err := rows.Scan(&chat.Id, &chat.Name, &chat.MemberCount, &chat.CreatorName, &chat.CreatedAt, &chat.JoinApproval)
+		if err != nil {
+			return nil, fmt.Errorf("scan chat: %w", err)
+		}
+		chats = append(chats, chat)
+	}
+
+	return chats, nil
+}
+
+func (r *repo) LastMessages(ctx context.Context, chatIds []int64) (map[int64]LastMessageDTO, error) {
+	builder := squirrel.Select(
+		"DISTINCT ON (m.chat_id) m.chat_id",
+		"m.text",
+		"m.from_username",
+		"m.created_at",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("messages m").
+		Where(squirrel.Eq{"m.chat_id": chatIds, "m.deleted_at": nil, "m.thread_root_id": nil}).
+		Where(notExpired).
+		OrderBy("m.chat_id", "m.created_at DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query last messages: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := make(map[int64]LastMessageDTO)
+	for rows.Next() {
+		var msg LastMessageDTO
+		err := rows.Scan(&msg.ChatId, &msg.Text, &msg.FromUsername, &msg.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
+
+		// Обрезаем текст для превью
+		if len(msg.Text) > 100 {
+			msg.Text = msg.Text[:100] + "..."
+		}
+
+		result[msg.ChatId] = msg
+	}
+
+	return result, nil
+}
+
+// MemberRole возвращает роль участника в чате, ErrNotFound если пользователь не в чате или чат удален
+func (r *repo) MemberRole(ctx context.Context, chatId int64, userId int64) (string, error) {
+	query := `
+		SELECT cm.role FROM chat_members cm
+		JOIN chats c ON c.id = cm.chat_id
+		WHERE cm.chat_id = $1 AND cm.user_id = $2 AND c.deleted_at IS NULL
+	`
+
+	var role string
+	err := r.db.QueryRow(ctx, query, chatId, userId).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("get member role: %w", err)
+	}
+
+	return role, nil
+}
+
+// SetMemberRole меняет роль участника, если она все еще fromRole.
+// ErrNotFound если участника нет в чате или его роль успели поменять
+func (r *repo) SetMemberRole(ctx context.Context, chatId int64, userId int64, fromRole string, toRole string) error {
+	builder := squirrel.Update("chat_members").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("role", toRole).
+		Where(squirrel.Eq{"chat_id": chatId, "user_id": userId, "role": fromRole})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build set member role query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("set member role: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// TransferOwnership передает чат другому участнику, прежний владелец становится админом.
+// Строки владельца и нового владельца блокируются, поэтому две одновременные передачи не оставят двух владельцев.
+// ErrNotFound если ownerId уже не владелец или newOwnerId не в чате
+func (r *repo) TransferOwnership(ctx context.Context, chatId int64, ownerId int64, newOwnerId int64) error {
+	query := `
+		WITH o AS (
+			SELECT user_id FROM chat_members WHERE chat_id = $1 AND user_id = $2 AND role = 'owner' FOR UPDATE
+		),
+		n AS (
+			SELECT user_id FROM chat_members WHERE chat_id = $1 AND user_id = $3 FOR UPDATE
+		),
+		m AS (
+			UPDATE chat_members SET role = CASE WHEN user_id = $2 THEN 'admin' ELSE 'owner' END
+			WHERE chat_id = $1 AND user_id IN ($2, $3) AND EXISTS (SELECT 1 FROM o) AND EXISTS (SELECT 1 FROM n)
+		)
+		UPDATE chats SET creator_id = $3
+		WHERE id = $1 AND EXISTS (SELECT 1 FROM o) AND EXISTS (SELECT 1 FROM n)
+	`
+
+	res, err := r.db.Exec(ctx, query, chatId, ownerId, newOwnerId)
+	if err != nil {
+		return fmt.Errorf("transfer ownership: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// PinMessage закрепляет сообщение, возвращает false если оно уже закреплено
+func (r *repo) PinMessage(ctx context.Context, chatId int64, messageId int64, userId int64, username string) (bool, error) {
+	builder := squirrel.Insert("pinned_messages").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("chat_id", "message_id", "pinned_by", "pinned_by_username").
+		Values(chatId, messageId, userId, username).
+		Suffix("ON CONFLICT (chat_id, message_id) DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build pin query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("pin message: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// UnpinMessage открепляет сообщение, возвращает false если оно не было закреплено
+func (r *repo) UnpinMessage(ctx context.Context, chatId int64, messageId int64) (bool, error) {
+	builder := squirrel.Delete("pinned_messages").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"chat_id": chatId, "message_id": messageId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build unpin query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("unpin message: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// PinnedMessages возвращает закрепленные сообщения чата, последние закрепленные первыми
+func (r *repo) PinnedMessages(ctx context.Context, chatId int64) ([]PinnedMessageDTO, error) {
+	builder := selectMessages().
+		Columns("p.pinned_by", "p.pinned_by_username", "p.pinned_at").
+		Join("pinned_messages p ON p.message_id = m.id").
+		Where(squirrel.Eq{"p.chat_id": chatId}).
+		OrderBy("p.pinned_at DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build pinned query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query pinned messages: %w", err)
+	}
+	defer rows.Close()
+
+	var pins []PinnedMessageDTO
+	for rows.Next() {
+		var pin PinnedMessageDTO
+		err := scanMessage(rows, &pin.Message, &pin.PinnedBy, &pin.PinnedByUsername, &pin.PinnedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan pinned message: %w", err)
+		}
+
+		pins = append(pins, pin)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate pinned messages: %w", err)
+	}
+
+	return pins, nil
+}
+
+// TopPins возвращает превью последнего закрепленного сообщения для каждого чата
+func (r *repo) TopPins(ctx context.Context, chatIds []int64) (map[int64]ReplyPreviewDTO, error) {
+	builder := squirrel.Select(
+		"DISTINCT ON (p.chat_id) p.chat_id",
+		"m.id",
+		"m.from_username",
+		"LEFT(m.text, 100)",
+		"m.message_type",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pinned_messages p").
+		Join("messages m ON m.id = p.message_id").
+		Where(squirrel.Eq{"p.chat_id": chatIds}).
+		Where(notExpired).
+		OrderBy("p.chat_id", "p.pinned_at DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query top pins: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]ReplyPreviewDTO)
+	for rows.Next() {
+		var chatId int64
+		var pin ReplyPreviewDTO
+		err := rows.Scan(&chatId, &pin.MessageId, &pin.From, &pin.Text, &pin.MessageType)
+		if err != nil {
+			return nil, fmt.Errorf("scan top pin: %w", err)
+		}
+
+		result[chatId] = pin
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate top pins: %w", err)
+	}
+
+	return result, nil
+}
+
+// SaveMentions сохраняет упоминания участников чата по username без учета регистра и возвращает id упомянутых.
+// Не участники чата и сам автор не упоминаются
+func (r *repo) SaveMentions(ctx context.Context, chatId int64, messageId int64, authorId int64, usernames []string) ([]int64, error) {
+	query := `
+		INSERT INTO message_mentions (message_id, chat_id, user_id)
+		SELECT $1, cm.chat_id, cm.user_id
+		FROM chat_members cm
+		WHERE cm.chat_id = $2 AND cm.user_id != $3 AND LOWER(cm.username) = ANY($4)
+		ON CONFLICT (message_id, user_id) DO NOTHING
+		RETURNING user_id
+	`
+
+	rows, err := r.db.Query(ctx, query, messageId, chatId, authorId, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("save mentions: %w", err)
+	}
+	defer rows.Close()
+
+	var userIds []int64
+	for rows.Next() {
+		var userId int64
+		if err := rows.Scan(&userId); err != nil {
+			return nil, fmt.Errorf("scan mention: %w", err)
+		}
+		userIds = append(userIds, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate mentions: %w", err)
+	}
+
+	return userIds, nil
+}
+
+// MessageMentions возвращает id участников, упомянутых в сообщении
+func (r *repo) MessageMentions(ctx context.Context, messageId int64) ([]int64, error) {
+	builder := squirrel.Select("user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		From("message_mentions").
+		Where(squirrel.Eq{"message_id": messageId}).
+		OrderBy("user_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build message mentions query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get message mentions: %w", err)
+	}
+	defer rows.Close()
+
+	var userIds []int64
+	for rows.Next() {
+		var userId int64
+		if err := rows.Scan(&userId); err != nil {
+			return nil, fmt.Errorf("scan mention: %w", err)
+		}
+		userIds = append(userIds, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate mentions: %w", err)
+	}
+
+	return userIds, nil
+}
+
+// SetMessageTTL задает время жизни новых сообщений чата, 0 - сообщения не исчезают
+func (r *repo) SetMessageTTL(ctx context.Context, chatId int64, ttlSeconds int32) error {
+	builder := squirrel.Update("chats").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("message_ttl_seconds", ttlSeconds).
+		Where(squirrel.Eq{"id": chatId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build query: %w", err)
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("set message ttl: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// PurgeExpiredMessages удаляет до limit истекших сообщений вместе с ответами в их тредах и возвращает удаленные.
+// SKIP LOCKED позволяет нескольким репликам чистить параллельно, не мешая друг другу
+func (r *repo) PurgeExpiredMessages(ctx context.Context, limit int) ([]ExpiredMessageDTO, error) {
+	query := `
+		WITH expired AS (
+			SELECT id FROM messages
+			WHERE expires_at <= NOW()
+			ORDER BY expires_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		DELETE FROM messages m
+		WHERE m.id IN (SELECT id FROM expired) OR m.thread_root_id IN (SELECT id FROM expired)
+		RETURNING m.id, m.chat_id, m.thread_root_id, m.file_url
+	`
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("purge expired messages: %w", err)
+	}
+	defer rows.Close()
+
+	var purged []ExpiredMessageDTO
+	for rows.Next() {
+		var msg ExpiredMessageDTO
+		var fileUrl *string
+		if err := rows.Scan(&msg.Id, &msg.ChatId, &msg.ThreadRootId, &fileUrl); err != nil {
+			return nil, fmt.Errorf("scan expired message: %w", err)
+		}
+		if fileUrl != nil {
+			msg.FileUrl = *fileUrl
+		}
+		purged = append(purged, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate expired messages: %w", err)
+	}
+
+	// Ответы в треде могли истечь раньше корня, пересчитываем счетчики оставшихся корней
+	purgedIds := make(map[int64]struct{}, len(purged))
+	for _, msg := range purged {
+		purgedIds[msg.Id] = struct{}{}
+	}
+	var rootIds []int64
+	for _, msg := range purged {
+		if msg.ThreadRootId == nil {
+			continue
+		}
+		if _, ok := purgedIds[*msg.ThreadRootId]; !ok {
+			rootIds = append(rootIds, *msg.ThreadRootId)
+		}
+	}
+
+	if len(rootIds) > 0 {
+		recount := `
+			UPDATE messages r
+			SET thread_reply_count = (SELECT COUNT(*) FROM messages t WHERE t.thread_root_id = r.id),
+				thread_last_reply_at = (SELECT MAX(t.created_at) FROM messages t WHERE t.thread_root_id = r.id)
+			WHERE r.id = ANY($1)
+		`
+		if _, err := r.db.Exec(ctx, recount, rootIds); err != nil {
+			return purged, fmt.Errorf("recount thread replies: %w", err)
+		}
+	}
+
+	return purged, nil
+}
+
+// FileUrlsInUse возвращает, какие из файлов еще используются сообщениями, например пересланными копиями,
+// или ждущими отправки отложенными сообщениями
+func (r *repo) FileUrlsInUse(ctx context.Context, urls []string) (map[string]bool, error) {
+	query := `
+		SELECT file_url FROM messages WHERE file_url = ANY($1)
+		UNION
+		SELECT file_url FROM scheduled_messages WHERE file_url = ANY($1)
+	`
+
+	rows, err := r.db.Query(ctx, query, urls)
+	if err != nil {
+		return nil, fmt.Errorf("query file urls in use: %w", err)
+	}
+	defer rows.Close()
+
+	inUse := make(map[string]bool)
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf("scan file url: %w", err)
+		}
+		inUse[url] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate file urls: %w", err)
+	}
+
+	return inUse, nil
+}
+
+// Polls опросы сообщений с подсчитанными голосами с точки зрения пользователя.
+// В анонимном опросе список проголосовавших не отдается
+func (r *repo) Polls(ctx context.Context, messageIds []int64, userId int64) (map[int64]PollDTO, error) {
+	if len(messageIds) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT p.message_id, p.multiple_choice, p.anonymous, p.closes_at,
+			p.closed_at IS NOT NULL OR COALESCE(p.closes_at <= NOW(), FALSE),
+			(SELECT COUNT(DISTINCT tv.user_id) FROM poll_votes tv WHERE tv.message_id = p.message_id),
+			o.id, o.text, COUNT(v.user_id), COALESCE(BOOL_OR(v.user_id = $2), FALSE),
+			COALESCE(ARRAY_AGG(v.user_id ORDER BY v.created_at) FILTER (WHERE v.user_id IS NOT NULL AND NOT p.anonymous), '{}')
+		FROM polls p
+		JOIN poll_options o ON o.message_id = p.message_id
+		LEFT JOIN poll_votes v ON v.option_id = o.id
+		WHERE p.message_id = ANY($1)
+		GROUP BY p.message_id, o.id
+		ORDER BY p.message_id, o.position
+	`
+
+	rows, err := r.db.Query(ctx, query, messageIds, userId)
+	if err != nil {
+		return nil, fmt.Errorf("query polls: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]PollDTO)
+	for rows.Next() {
+		var poll PollDTO
+		var option PollOptionDTO
+		err := rows.Scan(&poll.MessageId, &poll.MultipleChoice, &poll.Anonymous, &poll.ClosesAt, &poll.Closed, &poll.TotalVoters,
+			&option.Id, &option.Text, &option.Votes, &option.VotedByMe, &option.VoterIds)
+		if err != nil {
+			return nil, fmt.Errorf("scan poll option: %w", err)
+		}
+
+		// Строка на каждый вариант, общие поля опроса берем из первой
+		if existing, ok := result[poll.MessageId]; ok {
+			poll = existing
+		}
+		poll.Options = append(poll.Options, option)
+		result[poll.MessageId] = poll
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate polls: %w", err)
+	}
+
+	return result, nil
+}
+
+// VotePoll заменяет голос пользователя на выбранные варианты, пустой список отзывает голос.
+// Возвращает false, если опрос уже закрыт. Блокировка опроса не дает параллельным голосам одного пользователя
+// обойти ограничение одного варианта
+func (r *repo) VotePoll(ctx context.Context, messageId int64, userId int64, optionIds []int64) (bool, error) {
+	query := `
+		WITH poll AS (
+			SELECT message_id FROM polls
+			WHERE message_id = $1 AND closed_at IS NULL AND (closes_at IS NULL OR closes_at > NOW())
+			FOR UPDATE
+		),
+		removed AS (
+			DELETE FROM poll_votes
+			WHERE message_id IN (SELECT message_id FROM poll) AND user_id = $2 AND NOT (option_id = ANY($3))
+		),
+		added AS (
+			INSERT INTO poll_votes (option_id, message_id, user_id)
+			SELECT o.id, o.message_id, $2::bigint FROM poll_options o
+			WHERE o.message_id IN (SELECT message_id FROM poll) AND o.id = ANY($3)
+			ON CONFLICT (option_id, user_id) DO NOTHING
+		)
+		SELECT EXISTS (SELECT 1 FROM poll)
+	`
+
+	// nil ушел бы в запрос как NULL, и ANY не отфильтровал бы старые голоса
+	if optionIds == nil {
+		optionIds = []int64{}
+	}
+
+	var open bool
+	err := r.db.QueryRow(ctx, query, messageId, userId, optionIds).Scan(&open)
+	if err != nil {
+		return false, fmt.Errorf("vote poll: %w", err)
+	}
+
+	return open, nil
+}
+
+// ClosePoll закрывает опрос, возвращает false если он уже закрыт
+func (r *repo) ClosePoll(ctx context.Context, messageId int64) (bool, error) {
+	builder := squirrel.Update("polls").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("closed_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"message_id": messageId, "closed_at": nil}).
+		Where("(closes_at IS NULL OR closes_at > NOW())")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build close poll query: %w", err)
+	}
+
+	res, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("close poll: %w", err)
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// SaveLinkPreview сохраняет превью ссылки сообщения, повторное сохранение заменяет превью.
+// ErrNotFound, если сообщение удалили, пока загружалось превью
+func (r *repo) SaveLinkPreview(ctx context.Context, messageId int64, preview LinkPreviewDTO) error {
+	query := `
+		INSERT INTO link_previews (message_id, url, title, description, image_url, site_name)
+		SELECT id, $2, $3, $4, $5, $6 FROM messages WHERE id = $1 AND deleted_at IS NULL
+		ON CONFLICT (message_id) DO UPDATE SET url = EXCLUDED.url, title = EXCLUDED.title, description = EXCLUDED.description,
+			image_url = EXCLUDED.image_url, site_name = EXCLUDED.site_name, created_at = NOW()
+	`
+
+	res, err := r.db.Exec(ctx, query, messageId, preview.Url, preview.Title, preview.Description, preview.ImageUrl, preview.SiteName)
+	if err != nil {
+		return fmt.Errorf("save link preview: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// inviteColumns колонки приглашения в порядке сканирования scanInvite
+const inviteColumns = "id, chat_id, code_hint, created_by, max_uses, uses, expires_at, revoked_at, created_at"
+
+// validInvite условие, что приглашение i еще действует: не отозвано, не истекло и не исчерпано
+const validInvite = "i.revoked_at IS NULL AND (i.expires_at IS NULL OR i.expires_at > NOW()) AND (i.max_uses = 0 OR i.uses < i.max_uses)"
+
+func scanInvite(row pgx.Row, invite *InviteDTO) error {
+	return row.Scan(&invite.Id, &invite.ChatId, &invite.CodeHint, &invite.CreatedBy, &invite.MaxUses, &invite.Uses, &invite.ExpiresAt, &invite.RevokedAt, &invite.CreatedAt)
+}
+
+// CreateInvite сохраняет приглашение, код приходит уже захешированным
+func (r *repo) CreateInvite(ctx context.Context, invite InviteCreateDTO) (InviteDTO, error) {
+	builder := squirrel.Insert("chat_invites").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("chat_id", "code_hash", "code_hint", "created_by", "max_uses", "expires_at").
+		Values(invite.ChatId, invite.CodeHash, invite.CodeHint, invite.CreatedBy, invite.MaxUses, invite.ExpiresAt).
+		Suffix("RETURNING " + inviteColumns)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return InviteDTO{}, fmt.Errorf("build query: %w", err)
+	}
+
+	var created InviteDTO
+	if err := scanInvite(r.db.QueryRow(ctx, query, args...), &created); err != nil {
+		return InviteDTO{}, fmt.Errorf("create invite: %w", err)
+	}
+
+	return created, nil
+}
+
+// InviteById возвращает приглашение, ErrNotFound если его нет
+func (r *repo) InviteById(ctx context.Context, id int64) (*InviteDTO, error) {
+	query := "SELECT " + inviteColumns + " FROM chat_invites WHERE id = $1"
+
+	var invite InviteDTO
+	err := scanInvite(r.db.QueryRow(ctx, query, id), &invite)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get invite: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// ChatInvites возвращает все приглашения чата, новые первыми
+func (r *repo) ChatInvites(ctx context.Context, chatId int64) ([]InviteDTO, error) {
+	query := "SELECT " + inviteColumns + " FROM chat_invites WHERE chat_id = $1 ORDER BY created_at DESC, id DESC"
+
+	rows, err := r.db.Query(ctx, query, chatId)
+	if err != nil {
+		return nil, fmt.Errorf("query chat invites: %w", err)
+	}
+	defer rows.Close()
+
+	var invites []InviteDTO
+	for rows.Next() {
+		var invite InviteDTO
+		if err := scanInvite(rows, &invite); err != nil {
+			return nil, fmt.Errorf("scan invite: %w", err)
+		}
+		invites = append(invites, invite)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// RevokeInvite отзывает приглашение, ErrNotFound если его нет или оно уже отозвано
+func (r *repo) RevokeInvite(ctx context.Context, id int64) error {
+	query := "UPDATE chat_invites SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL"
+
+	res, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("revoke invite: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// InvitePreview возвращает чат по действующему приглашению, ErrNotFound если приглашение не действует или чат удален
+func (r *repo) InvitePreview(ctx context.Context, codeHash []byte, userId int64) (InvitePreviewDTO, error) {
+	query := `
+		SELECT c.id, c.name,
+			(SELECT COUNT(*) FROM chat_members WHERE chat_id = c.id),
+			EXISTS (SELECT 1 FROM chat_members WHERE chat_id = c.id AND user_id = $2)
+		FROM chat_invites i
+		JOIN chats c ON c.id = i.chat_id AND c.deleted_at IS NULL
+		WHERE i.code_hash = $1 AND ` + validInvite
+
+	var preview InvitePreviewDTO
+	err := r.db.QueryRow(ctx, query, codeHash, userId).Scan(&preview.ChatId, &preview.Name, &preview.MemberCount, &preview.IsMember)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return InvitePreviewDTO{}, ErrNotFound
+		}
+		return InvitePreviewDTO{}, fmt.Errorf("get invite preview: %w", err)
+	}
+
+	return preview, nil
+}
+
+// UseInvite добавляет пользователя в чат по действующему приглашению и засчитывает использование.
+// Строка приглашения блокируется, поэтому max_uses не превышается при одновременных вступлениях.
+// Возвращает id чата и false, если пользователь уже был в чате - тогда использование не засчитывается.
+// ErrNotFound если приглашение не действует или чат удален
+func (r *repo) UseInvite(ctx context.Context, codeHash []byte, userId int64, username string) (int64, bool, error) {
+	query := `
+		WITH invite AS (
+			SELECT i.id, i.chat_id
+			FROM chat_invites i
+			JOIN chats c ON c.id = i.chat_id AND c.deleted_at IS NULL
+			WHERE i.code_hash = $1 AND ` + validInvite + `
+			FOR UPDATE OF i
+		), member AS (
+			INSERT INTO chat_members (chat_id, user_id, username, role)
+			SELECT chat_id, $2, $3, 'member' FROM invite
+			ON CONFLICT (chat_id, user_id) DO NOTHING
+			RETURNING chat_id
+		), used AS (
+			INSERT INTO chat_invite_uses (invite_id, user_id)
+			SELECT id, $2 FROM invite WHERE EXISTS (SELECT 1 FROM member)
+			ON CONFLICT (invite_id, user_id) DO UPDATE SET used_at = NOW()
+		), counted AS (
+			UPDATE chat_invites SET uses = uses + 1
+			WHERE id IN (SELECT id FROM invite) AND EXISTS (SELECT 1 FROM member)
+		)
+		SELECT chat_id, EXISTS (SELECT 1 FROM member) FROM invite
+	`
+
+	var chatId int64
+	var joined bool
+	err := r.db.QueryRow(ctx, query, codeHash, userId, username).Scan(&chatId, &joined)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, ErrNotFound
+		}
+		return 0, false, fmt.Errorf("use invite: %w", err)
+	}
+
+	return chatId, joined, nil
+}
+
+// joinRequestColumns колонки заявки в порядке сканирования scanJoinRequest
+const joinRequestColumns = "id, chat_id, user_id, username, status, created_at"
+
+func scanJoinRequest(row pgx.Row, request *JoinRequestDTO) error {
+	return row.Scan(&request.Id, &request.ChatId, &request.UserId, &request.Username, &request.Status, &request.CreatedAt)
+}
+
+// CreateJoinRequest создает заявку на вступление. Повторная заявка, пока первая ожидает решения,
+// не создает новую, а возвращает существующую
+func (r *repo) CreateJoinRequest(ctx context.Context, chatId int64, userId int64, username string) (JoinRequestDTO, error) {
+	query := `
+		INSERT INTO chat_join_requests (chat_id, user_id, username)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (chat_id, user_id) WHERE status = 'pending' DO UPDATE SET username = EXCLUDED.username
+		RETURNING ` + joinRequestColumns
+
+	var request JoinRequestDTO
+	if err := scanJoinRequest(r.db.QueryRow(ctx, query, chatId, userId, username), &request); err != nil {
+		return JoinRequestDTO{}, fmt.Errorf("create join request: %w", err)
+	}
+
+	return request, nil
+}
+
+// JoinRequestById возвращает заявку, ErrNotFound если ее нет
+func (r *repo) JoinRequestById(ctx context.Context, id int64) (*JoinRequestDTO, error) {
+	query := "SELECT " + joinRequestColumns + " FROM chat_join_requests WHERE id = $1"
+
+	var request JoinRequestDTO
+	err := scanJoinRequest(r.db.QueryRow(ctx, query, id), &request)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get join request: %w", err)
+	}
+
+	return &request, nil
+}
+
+// PendingJoinRequests возвращает ожидающие решения заявки чата, старые первыми
+func (r *repo) PendingJoinRequests(ctx context.Context, chatId int64) ([]JoinRequestDTO, error) {
+	query := "SELECT " + joinRequestColumns + " FROM chat_join_requests WHERE chat_id = $1 AND status = 'pending' ORDER BY created_at, id"
+
+	rows, err := r.db.Query(ctx, query, chatId)
+	if err != nil {
+		return nil, fmt.Errorf("query join requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []JoinRequestDTO
+	for rows.Next() {
+		var request JoinRequestDTO
+		if err := scanJoinRequest(rows, &request); err != nil {
+			return nil, fmt.Errorf("scan join request: %w", err)
+		}
+		requests = append(requests, request)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate join requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ResolveJoinRequest одобряет или отклоняет ожидающую заявку. При одобрении заявитель сразу добавляется в участники.
+// ErrNotFound если заявки нет, по ней уже приняли решение или чат удален
+func (r *repo) ResolveJoinRequest(ctx context.Context, id int64, decidedBy int64, approve bool) (JoinRequestDTO, error) {
+	status := "rejected"
+	if approve {
+		status = "approved"
+	}
+
+	query := `
+		WITH resolved AS (
+			UPDATE chat_join_requests jr SET status = $3, decided_by = $2, decided_at = NOW()
+			FROM chats c
+			WHERE jr.id = $1 AND jr.status = 'pending' AND c.id = jr.chat_id AND c.deleted_at IS NULL
+			RETURNING jr.id, jr.chat_id, jr.user_id, jr.username, jr.status, jr.created_at
+		), member AS (
+			INSERT INTO chat_members (chat_id, user_id, username, role)
+			SELECT chat_id, user_id, username, 'member' FROM resolved WHERE status = 'approved'
+			ON CONFLICT (chat_id, user_id) DO NOTHING
+		)
+		SELECT ` + joinRequestColumns + ` FROM resolved
+	`
+
+	var request JoinRequestDTO
+	err := scanJoinRequest(r.db.QueryRow(ctx, query, id, decidedBy, status), &request)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return JoinRequestDTO{}, ErrNotFound
+		}
+		return JoinRequestDTO{}, fmt.Errorf("resolve join request: %w", err)
+	}
+
+	return request, nil
 }
@@ -0,0 +1,40 @@
+package scheduled
+
+import "time"
+
+// ScheduledMessageCreateDTO отложенное сообщение для сохранения
+type ScheduledMessageCreateDTO struct {
+	ChatId        int64
+	UserId        int64
+	FromUsername  string
+	Text          string
+	MessageType   string
+	VoiceDuration float32
+	FileUrl       string
+	FileName      string
+	FileSize      int64
+	ReplyToId     *int64 // nil если это не ответ
+	ThreadRootId  *int64 // nil если сообщение в ленту чата
+	SendAt        time.Time
+}
+
+// ScheduledMessageDTO сохраненное отложенное сообщение
+type ScheduledMessageDTO struct {
+	Id            int64
+	ChatId        int64
+	UserId        int64
+	FromUsername  string
+	Text          string
+	MessageType   string
+	VoiceDuration float32
+	FileUrl       string
+	FileName      string
+	FileSize      int64
+	ReplyToId     *int64
+	ThreadRootId  *int64
+	SendAt        time.Time
+	CreatedAt     time.Time
+	Attempts      int32
+	LastError     string
+	FailedAt      *time.Time // Не nil если попытки отправки закончились
+}
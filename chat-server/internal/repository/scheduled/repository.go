@@ -0,0 +1,183 @@
+package scheduled
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrNotFound = errors.New("scheduled message not found")
+
+// scheduledColumns колонки отложенного сообщения в порядке сканирования scanScheduled
+var scheduledColumns = []string{
+	"id", "chat_id", "user_id", "from_username", "text", "message_type", "voice_duration", "file_url", "file_name", "file_size",
+	"reply_to_id", "thread_root_id", "send_at", "created_at", "attempts", "last_error", "failed_at",
+}
+
+type ScheduledRepository interface {
+	Create(ctx context.Context, msg ScheduledMessageCreateDTO) (ScheduledMessageDTO, error)
+	List(ctx context.Context, userId int64, chatId int64) ([]ScheduledMessageDTO, error)
+	Cancel(ctx context.Context, id int64, userId int64) error
+
+	// Диспетчер
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]ScheduledMessageDTO, error)
+	Complete(ctx context.Context, id int64) error
+	Fail(ctx context.Context, id int64, reason string, retryAfter time.Duration, permanent bool) error
+}
+
+type scheduledRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewScheduledRepository(db *pgxpool.Pool) ScheduledRepository {
+	return &scheduledRepo{db: db}
+}
+
+// Create - сохранить отложенное сообщение
+func (r *scheduledRepo) Create(ctx context.Context, msg ScheduledMessageCreateDTO) (ScheduledMessageDTO, error) {
+	builder := squirrel.Insert("scheduled_messages").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("chat_id", "user_id", "from_username", "text", "message_type", "voice_duration", "file_url", "file_name", "file_size", "reply_to_id", "thread_root_id", "send_at").
+		Values(msg.ChatId, msg.UserId, msg.FromUsername, msg.Text, msg.MessageType, msg.VoiceDuration, msg.FileUrl, msg.FileName, msg.FileSize, msg.ReplyToId, msg.ThreadRootId, msg.SendAt.UTC()).
+		Suffix("RETURNING " + columnList())
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return ScheduledMessageDTO{}, fmt.Errorf("build insert query: %w", err)
+	}
+
+	var res ScheduledMessageDTO
+	err = scanScheduled(r.db.QueryRow(ctx, query, args...), &res)
+	if err != nil {
+		return ScheduledMessageDTO{}, fmt.Errorf("create scheduled message: %w", err)
+	}
+
+	return res, nil
+}
+
+// List - отложенные сообщения пользователя в порядке отправки, chatId 0 - по всем чатам
+func (r *scheduledRepo) List(ctx context.Context, userId int64, chatId int64) ([]ScheduledMessageDTO, error) {
+	builder := squirrel.Select(scheduledColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("scheduled_messages").
+		Where(squirrel.Eq{"user_id": userId}).
+		OrderBy("send_at", "id")
+	if chatId > 0 {
+		builder = builder.Where(squirrel.Eq{"chat_id": chatId})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query scheduled messages: %w", err)
+	}
+	defer rows.Close()
+
+	return collectScheduled(rows)
+}
+
+// Cancel - отменить отложенное сообщение автора. Сообщение, которое уже отправляется, отменить нельзя
+func (r *scheduledRepo) Cancel(ctx context.Context, id int64, userId int64) error {
+	query := `
+		DELETE FROM scheduled_messages
+		WHERE id = $1 AND user_id = $2 AND (claimed_until IS NULL OR claimed_until < NOW())
+	`
+
+	tag, err := r.db.Exec(ctx, query, id, userId)
+	if err != nil {
+		return fmt.Errorf("cancel scheduled message: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ClaimDue - забрать в аренду до limit сообщений, время которых наступило.
+// SKIP LOCKED не дает двум репликам забрать одну строку, аренда на lease защищает от повторной отправки,
+// а если реплика упала, после истечения аренды строку заберет другая
+func (r *scheduledRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]ScheduledMessageDTO, error) {
+	query := `
+		UPDATE scheduled_messages
+		SET claimed_until = NOW() + make_interval(secs => $2), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM scheduled_messages
+			WHERE send_at <= NOW() AND failed_at IS NULL AND (claimed_until IS NULL OR claimed_until < NOW())
+			ORDER BY send_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + columnList()
+
+	rows, err := r.db.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("claim due scheduled messages: %w", err)
+	}
+	defer rows.Close()
+
+	return collectScheduled(rows)
+}
+
+// Complete - сообщение отправлено, удаляем его из очереди
+func (r *scheduledRepo) Complete(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, "DELETE FROM scheduled_messages WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("complete scheduled message: %w", err)
+	}
+	return nil
+}
+
+// Fail - отправка не удалась: снимаем аренду и переносим попытку, permanent - больше не пытаться
+func (r *scheduledRepo) Fail(ctx context.Context, id int64, reason string, retryAfter time.Duration, permanent bool) error {
+	query := `
+		UPDATE scheduled_messages
+		SET claimed_until = NULL,
+			last_error = $2,
+			send_at = CASE WHEN $4 THEN send_at ELSE NOW() + make_interval(secs => $3) END,
+			failed_at = CASE WHEN $4 THEN NOW() ELSE NULL END
+		WHERE id = $1
+	`
+
+	_, err := r.db.Exec(ctx, query, id, reason, retryAfter.Seconds(), permanent)
+	if err != nil {
+		return fmt.Errorf("fail scheduled message: %w", err)
+	}
+	return nil
+}
+
+func columnList() string {
+	return strings.Join(scheduledColumns, ", ")
+}
+
+func scanScheduled(row pgx.Row, msg *ScheduledMessageDTO) error {
+	return row.Scan(&msg.Id, &msg.ChatId, &msg.UserId, &msg.FromUsername, &msg.Text, &msg.MessageType, &msg.VoiceDuration, &msg.FileUrl, &msg.FileName, &msg.FileSize,
+		&msg.ReplyToId, &msg.ThreadRootId, &msg.SendAt, &msg.CreatedAt, &msg.Attempts, &msg.LastError, &msg.FailedAt)
+}
+
+func collectScheduled(rows pgx.Rows) ([]ScheduledMessageDTO, error) {
+	var res []ScheduledMessageDTO
+	for rows.Next() {
+		var msg ScheduledMessageDTO
+		if err := scanScheduled(rows, &msg); err != nil {
+			return nil, fmt.Errorf("scan scheduled message: %w", err)
+		}
+		res = append(res, msg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,16 @@
+package unread
+
+import "time"
+
+// ReadPointerDTO указатель прочтения участника чата
+type ReadPointerDTO struct {
+	LastReadMessageId int64
+	ReadAt            time.Time
+}
+
+// ReadReceiptDTO участник, прочитавший сообщение
+type ReadReceiptDTO struct {
+	UserId   int64
+	Username string
+	ReadAt   time.Time
+}
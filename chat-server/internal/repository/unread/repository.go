@@ -2,18 +2,28 @@ package unread
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type UnreadRepository interface {
-	IncrementUnreadForMembers(ctx context.Context, chatId, senderId int64) error
-	MarkAsRead(ctx context.Context, chatId, userId int64) error
+	MarkAsRead(ctx context.Context, chatId, userId, upToMessageId int64) (ReadPointerDTO, bool, error)
 	AllUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error)
+	AllMentionCounts(ctx context.Context, userId int64) (map[int64]int32, error)
+	ReadReceipts(ctx context.Context, chatId, messageId, authorId int64) ([]ReadReceiptDTO, error)
 	DeleteForChat(ctx context.Context, chatId int64) error
 	InitForMember(ctx context.Context, chatId, userId int64) error
+	DeleteForMember(ctx context.Context, chatId, userId int64) error
+
+	// Треды
+	FollowThread(ctx context.Context, rootId, userId int64) error
+	IncrementThreadUnread(ctx context.Context, rootId, senderId int64) error
+	MarkThreadRead(ctx context.Context, rootId, userId int64) error
+	AllThreadUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error)
 }
 
 type unreadRepo struct {
@@ -24,47 +34,133 @@ func NewUnreadRepository(db *pgxpool.Pool) UnreadRepository {
 	return &unreadRepo{db: db}
 }
 
-// IncrementUnreadForMembers - увеличить счётчик для всех участников кроме отправителя.
-func (r *unreadRepo) IncrementUnreadForMembers(ctx context.Context, chatId, senderId int64) error {
+// MarkAsRead - сдвинуть указатель прочтения до сообщения upToMessageId (0 - до последнего сообщения ленты).
+// Указатель только растет, второе значение false если он не сдвинулся
+func (r *unreadRepo) MarkAsRead(ctx context.Context, chatId, userId, upToMessageId int64) (ReadPointerDTO, bool, error) {
 	query := `
-		INSERT INTO chat_unread (chat_id, user_id, count)
-		SELECT $1, cm.user_id, 1
-		FROM chat_members cm
-		WHERE cm.chat_id = $1 AND cm.user_id != $2
+		WITH target AS (
+			SELECT COALESCE(MAX(id), 0) AS id FROM messages
+			WHERE chat_id = $1 AND thread_root_id IS NULL AND ($3 = 0 OR id <= $3)
+		)
+		INSERT INTO chat_unread (chat_id, user_id, last_read_message_id, last_read_at)
+		SELECT $1, $2, target.id, NOW() FROM target
 		ON CONFLICT (chat_id, user_id)
-		DO UPDATE SET count = chat_unread.count + 1
+		DO UPDATE SET last_read_message_id = EXCLUDED.last_read_message_id, last_read_at = NOW()
+		WHERE chat_unread.last_read_message_id < EXCLUDED.last_read_message_id
+		RETURNING last_read_message_id, last_read_at
 	`
-	_, err := r.db.Exec(ctx, query, chatId, senderId)
+
+	var pointer ReadPointerDTO
+	err := r.db.QueryRow(ctx, query, chatId, userId, upToMessageId).Scan(&pointer.LastReadMessageId, &pointer.ReadAt)
 	if err != nil {
-		return fmt.Errorf("increment unread for members: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ReadPointerDTO{}, false, nil
+		}
+		return ReadPointerDTO{}, false, fmt.Errorf("mark as read: %w", err)
 	}
-	return nil
+
+	return pointer, pointer.LastReadMessageId > 0, nil
 }
 
-// MarkAsRead - обнулить счётчик непрочитанных
-func (r *unreadRepo) MarkAsRead(ctx context.Context, chatId, userId int64) error {
+// AllUnreadCounts - получаем все непрочитанные для пользователя, считаем сообщения ленты после указателя прочтения.
+// Свои, удаленные, истекшие и скрытые сообщения не считаются
+func (r *unreadRepo) AllUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error) {
 	query := `
-		INSERT INTO chat_unread (chat_id, user_id, count, last_read_at)
-		VALUES ($1, $2, 0, NOW())
-		ON CONFLICT (chat_id, user_id)
-		DO UPDATE SET count = 0, last_read_at = NOW()
+		SELECT cm.chat_id, COUNT(m.id)
+		FROM chat_members cm
+		LEFT JOIN chat_unread cu ON cu.chat_id = cm.chat_id AND cu.user_id = cm.user_id
+		JOIN messages m ON m.chat_id = cm.chat_id AND m.id > COALESCE(cu.last_read_message_id, 0)
+		WHERE cm.user_id = $1
+			AND m.user_id != $1
+			AND m.thread_root_id IS NULL
+			AND m.deleted_at IS NULL
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+			AND NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.message_id = m.id AND h.user_id = $1)
+		GROUP BY cm.chat_id
 	`
-	_, err := r.db.Exec(ctx, query, chatId, userId)
+
+	rows, err := r.db.Query(ctx, query, userId)
 	if err != nil {
-		return fmt.Errorf("mark as read: %w", err)
+		return nil, fmt.Errorf("query unread counts: %w", err)
 	}
-	return nil
+	defer rows.Close()
+
+	counts := make(map[int64]int32)
+	for rows.Next() {
+		var chatId int64
+		var count int32
+		err := rows.Scan(&chatId, &count)
+		if err != nil {
+			return nil, fmt.Errorf("scan unread count: %w", err)
+		}
+		counts[chatId] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
 }
 
-// AllUnreadCounts - получаем все непрочитанные для пользователя
-func (r *unreadRepo) AllUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error) {
-	builder := squirrel.Select("chat_id", "count").
+// AllMentionCounts - непрочитанные упоминания пользователя по чатам. Упоминания в ленте считаем после указателя
+// прочтения чата, а в ответах треда - после последнего прочтения треда, ведь указатель чата по тредам не двигается.
+// Удаленные, истекшие и скрытые сообщения не считаются
+func (r *unreadRepo) AllMentionCounts(ctx context.Context, userId int64) (map[int64]int32, error) {
+	query := `
+		SELECT mm.chat_id, COUNT(*)
+		FROM message_mentions mm
+		JOIN chat_members cm ON cm.chat_id = mm.chat_id AND cm.user_id = mm.user_id
+		JOIN messages m ON m.id = mm.message_id
+		LEFT JOIN chat_unread cu ON cu.chat_id = mm.chat_id AND cu.user_id = mm.user_id
+		LEFT JOIN thread_unread tu ON tu.thread_root_id = m.thread_root_id AND tu.user_id = mm.user_id
+		WHERE mm.user_id = $1
+			AND (
+				(m.thread_root_id IS NULL AND mm.message_id > COALESCE(cu.last_read_message_id, 0))
+				OR (m.thread_root_id IS NOT NULL AND (tu.last_read_at IS NULL OR m.created_at > tu.last_read_at))
+			)
+			AND m.deleted_at IS NULL
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+			AND NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.message_id = m.id AND h.user_id = $1)
+		GROUP BY mm.chat_id
+	`
+
+	rows, err := r.db.Query(ctx, query, userId)
+	if err != nil {
+		return nil, fmt.Errorf("query mention counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int64]int32)
+	for rows.Next() {
+		var chatId int64
+		var count int32
+		err := rows.Scan(&chatId, &count)
+		if err != nil {
+			return nil, fmt.Errorf("scan mention count: %w", err)
+		}
+		counts[chatId] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// ReadReceipts - участники чата, кроме автора, у которых указатель прочтения дошел до сообщения
+func (r *unreadRepo) ReadReceipts(ctx context.Context, chatId, messageId, authorId int64) ([]ReadReceiptDTO, error) {
+	builder := squirrel.Select("cu.user_id", "cm.username", "cu.last_read_at").
 		PlaceholderFormat(squirrel.Dollar).
-		From("chat_unread").
+		From("chat_unread cu").
+		Join("chat_members cm ON cm.chat_id = cu.chat_id AND cm.user_id = cu.user_id").
 		Where(squirrel.And{
-			squirrel.Eq{"user_id": userId},
-			squirrel.Gt{"count": 0},
-		})
+			squirrel.Eq{"cu.chat_id": chatId},
+			squirrel.GtOrEq{"cu.last_read_message_id": messageId},
+			squirrel.NotEq{"cu.user_id": authorId},
+		}).
+		OrderBy("cu.last_read_at")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -73,26 +169,25 @@ func (r *unreadRepo) AllUnreadCounts(ctx context.Context, userId int64) (map[int
 
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("query unread counts: %w", err)
+		return nil, fmt.Errorf("query read receipts: %w", err)
 	}
 	defer rows.Close()
 
-	counts := make(map[int64]int32)
+	var receipts []ReadReceiptDTO
 	for rows.Next() {
-		var chatId int64
-		var count int32
-		err := rows.Scan(&chatId, &count)
+		var receipt ReadReceiptDTO
+		err := rows.Scan(&receipt.UserId, &receipt.Username, &receipt.ReadAt)
 		if err != nil {
-			return nil, fmt.Errorf("scan unread count: %w", err)
+			return nil, fmt.Errorf("scan read receipt: %w", err)
 		}
-		counts[chatId] = count
+		receipts = append(receipts, receipt)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	return counts, nil
+	return receipts, nil
 }
 
 // DeleteForChat - удаляем все записи непрочитанных при удалении чата
@@ -114,11 +209,11 @@ func (r *unreadRepo) DeleteForChat(ctx context.Context, chatId int64) error {
 	return nil
 }
 
-// InitForMember - создаем запись при добавлении участника в чат
+// InitForMember - создаем запись при добавлении участника в чат, история до вступления считается прочитанной
 func (r *unreadRepo) InitForMember(ctx context.Context, chatId, userId int64) error {
 	query := `
-		INSERT INTO chat_unread (chat_id, user_id, count)
-		VALUES ($1, $2, 0)
+		INSERT INTO chat_unread (chat_id, user_id, last_read_message_id)
+		SELECT $1, $2, COALESCE(MAX(id), 0) FROM messages WHERE chat_id = $1 AND thread_root_id IS NULL
 		ON CONFLICT (chat_id, user_id) DO NOTHING
 	`
 	_, err := r.db.Exec(ctx, query, chatId, userId)
@@ -127,3 +222,100 @@ func (r *unreadRepo) InitForMember(ctx context.Context, chatId, userId int64) er
 	}
 	return nil
 }
+
+// DeleteForMember - удаляем непрочитанные чата и его тредов у вышедшего участника
+func (r *unreadRepo) DeleteForMember(ctx context.Context, chatId, userId int64) error {
+	query := `
+		WITH threads AS (
+			DELETE FROM thread_unread
+			WHERE user_id = $2 AND thread_root_id IN (SELECT id FROM messages WHERE chat_id = $1)
+		)
+		DELETE FROM chat_unread WHERE chat_id = $1 AND user_id = $2
+	`
+	_, err := r.db.Exec(ctx, query, chatId, userId)
+	if err != nil {
+		return fmt.Errorf("delete unread for member: %w", err)
+	}
+	return nil
+}
+
+// FollowThread - подписать пользователя на непрочитанные треда
+func (r *unreadRepo) FollowThread(ctx context.Context, rootId, userId int64) error {
+	query := `
+		INSERT INTO thread_unread (thread_root_id, user_id, count)
+		VALUES ($1, $2, 0)
+		ON CONFLICT (thread_root_id, user_id) DO NOTHING
+	`
+	_, err := r.db.Exec(ctx, query, rootId, userId)
+	if err != nil {
+		return fmt.Errorf("follow thread: %w", err)
+	}
+	return nil
+}
+
+// IncrementThreadUnread - увеличить счётчик треда всем подписчикам кроме отправителя
+func (r *unreadRepo) IncrementThreadUnread(ctx context.Context, rootId, senderId int64) error {
+	query := `
+		UPDATE thread_unread SET count = count + 1
+		WHERE thread_root_id = $1 AND user_id != $2
+	`
+	_, err := r.db.Exec(ctx, query, rootId, senderId)
+	if err != nil {
+		return fmt.Errorf("increment thread unread: %w", err)
+	}
+	return nil
+}
+
+// MarkThreadRead - обнулить счётчик треда, заодно подписывает на тред
+func (r *unreadRepo) MarkThreadRead(ctx context.Context, rootId, userId int64) error {
+	query := `
+		INSERT INTO thread_unread (thread_root_id, user_id, count, last_read_at)
+		VALUES ($1, $2, 0, NOW())
+		ON CONFLICT (thread_root_id, user_id)
+		DO UPDATE SET count = 0, last_read_at = NOW()
+	`
+	_, err := r.db.Exec(ctx, query, rootId, userId)
+	if err != nil {
+		return fmt.Errorf("mark thread as read: %w", err)
+	}
+	return nil
+}
+
+// AllThreadUnreadCounts - непрочитанные по тредам пользователя, ключ - id корневого сообщения
+func (r *unreadRepo) AllThreadUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error) {
+	builder := squirrel.Select("thread_root_id", "count").
+		PlaceholderFormat(squirrel.Dollar).
+		From("thread_unread").
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": userId},
+			squirrel.Gt{"count": 0},
+		})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query thread unread counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int64]int32)
+	for rows.Next() {
+		var rootId int64
+		var count int32
+		err := rows.Scan(&rootId, &count)
+		if err != nil {
+			return nil, fmt.Errorf("scan thread unread count: %w", err)
+		}
+		counts[rootId] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return counts, nil
+}
@@ -2,17 +2,89 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
 	"go.uber.org/zap"
 )
 
-func (s *service) MarkChatRead(ctx context.Context, chatId, userId int64) error {
-	err := s.UnreadRepository.MarkAsRead(ctx, chatId, userId)
+// MarkChatRead сдвигает указатель прочтения до upToMessageId (0 - до последнего сообщения)
+// и рассылает его подписчикам чата, если он изменился
+func (s *service) MarkChatRead(ctx context.Context, chatId, userId int64, username string, upToMessageId int64) error {
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		return fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+	}
+
+	pointer, moved, err := s.UnreadRepository.MarkAsRead(ctx, chatId, userId, upToMessageId)
 	if err != nil {
 		logger.Error("failed to mark chat as read", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
 		return fmt.Errorf("mark chat as read: %w", err)
 	}
+
+	if !moved {
+		return nil
+	}
+
+	s.broadcastMessage(ctx, chatId, MessageDTO{
+		Id:          pointer.LastReadMessageId,
+		MessageType: MessageTypeReadReceipt,
+		From:        username,
+		CreatedAt:   pointer.ReadAt,
+		ReadReceipt: &ReadReceiptDTO{
+			UserId:            userId,
+			Username:          username,
+			LastReadMessageId: pointer.LastReadMessageId,
+			ReadAt:            pointer.ReadAt,
+		},
+	})
+
 	return nil
 }
+
+// GetReadReceipts возвращает участников, прочитавших сообщение. Автор сообщения в список не входит
+func (s *service) GetReadReceipts(ctx context.Context, userId int64, chatId int64, messageId int64) ([]ReadReceiptDTO, error) {
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		return nil, fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+	}
+
+	msg, err := s.ChatRepository.MessageById(ctx, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+		}
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+	if msg.ChatId != chatId || msg.ThreadRootId != nil {
+		return nil, fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+	}
+
+	receipts, err := s.UnreadRepository.ReadReceipts(ctx, chatId, messageId, msg.UserId)
+	if err != nil {
+		logger.Error("failed to get read receipts", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+		return nil, fmt.Errorf("get read receipts: %w", err)
+	}
+
+	res := make([]ReadReceiptDTO, 0, len(receipts))
+	for _, receipt := range receipts {
+		res = append(res, ReadReceiptDTO{
+			UserId:            receipt.UserId,
+			Username:          receipt.Username,
+			LastReadMessageId: messageId,
+			ReadAt:            receipt.ReadAt,
+		})
+	}
+
+	return res, nil
+}
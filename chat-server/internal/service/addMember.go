@@ -23,10 +23,13 @@ func (s *service) AddMember(ctx context.Context, chatId int64, userId int64, use
 		return errors.New("cannot add members to direct chat")
 	}
 
-	// Если закрытый чат, то только владелец может добавить
-	if !chat.IsPublic && chat.CreatorId != userId {
-		logger.Warn("only owner can add members to private chat", zap.Int64("chat_id", chatId))
-		return errors.New("only owner can add members to private chat")
+	// В публичный чат может пригласить любой, кому можно писать, в закрытый - только роли с правом добавлять участников
+	perm := PermAddMembers
+	if chat.IsPublic {
+		perm = PermSendMessages
+	}
+	if _, err := s.checkPermission(ctx, chatId, userId, perm); err != nil {
+		return err
 	}
 
 	// Проверяем что пользователь с таким именем существует
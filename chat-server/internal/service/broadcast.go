@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository/broadcast"
+	"go.uber.org/zap"
+)
+
+// broadcastMessage доставляет сообщение подписчикам этой реплики и публикует его для остальных реплик
+func (s *service) broadcastMessage(ctx context.Context, chatId int64, msg MessageDTO) {
+	s.deliverLocal(chatId, msg)
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		logger.Error("failed to marshal broadcast message", zap.Int64("chat_id", chatId), zap.Error(err))
+		return
+	}
+
+	err = s.BroadcastBus.Publish(ctx, chatId, payload)
+	if err != nil {
+		logger.Warn("failed to publish message to other instances", zap.Int64("chat_id", chatId), zap.Error(err))
+	}
+}
+
+// broadcastOnlineUsers рассылает актуальный список онлайн пользователей чата со всех реплик
+func (s *service) broadcastOnlineUsers(ctx context.Context, chatId int64) {
+	msg := MessageDTO{
+		MessageType: MessageTypeOnlineUsers,
+		OnlineUsers: s.OnlineUsers(ctx, chatId),
+		CreatedAt:   time.Now(),
+	}
+
+	s.broadcastMessage(ctx, chatId, msg)
+}
+
+// RunBroadcastListener принимает события от других реплик и доставляет их локальным подписчикам
+func (s *service) RunBroadcastListener(ctx context.Context) error {
+	logger.Info("broadcast listener started")
+
+	return s.BroadcastBus.Listen(ctx, func(event broadcast.Event) {
+		var msg MessageDTO
+		if err := json.Unmarshal(event.Payload, &msg); err != nil {
+			logger.Warn("failed to unmarshal broadcast event", zap.Int64("chat_id", event.ChatId), zap.Error(err))
+			return
+		}
+
+		s.deliverLocal(event.ChatId, msg)
+	})
+}
+
+// deliverLocal отдает сообщение подписчикам этой реплики: сообщения треда - в комнату треда, остальные - в комнату чата
+func (s *service) deliverLocal(chatId int64, msg MessageDTO) {
+	room := s.getRoom(chatId)
+	if msg.ThreadRootId != 0 {
+		room = s.getThreadRoom(msg.ThreadRootId)
+	}
+
+	if room != nil {
+		room.BroadcastMessage(msg)
+	}
+
+	// Удаленный чат больше не слушаем: событие уже в каналах, закрываем их.
+	// Вышедший участник тоже получил событие, его подписки закрываем на той реплике, где они есть.
+	// Подписчики тредов могут быть и там, где комнаты чата нет, поэтому проверяем событие в любом случае
+	switch {
+	case msg.MessageType == MessageTypeChatDeleted:
+		s.closeRoom(chatId)
+	case msg.MessageType == MessageTypeMemberLeft && msg.MemberLeft != nil:
+		s.DisconnectFromChat(chatId, msg.MemberLeft.UserId)
+		s.disconnectFromThreads(chatId, msg.MemberLeft.UserId)
+	}
+}
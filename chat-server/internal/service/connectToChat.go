@@ -63,11 +63,17 @@ func (s *service) ConnectToChat(ctx context.Context, userId int64, username stri
 		zap.Int("online_count", room.GetOnlineUsersCount()),
 	)
 
+	// Отмечаем онлайн в общем для всех реплик списке
+	err = s.BroadcastBus.AddOnline(ctx, chatId, userId, username)
+	if err != nil {
+		logger.Warn("failed to add online user", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+	}
+
 	// Отправляем историю сообщений
-	go s.sendRecentMessages(ctx, chatId, msgChan)
+	go s.sendRecentMessages(ctx, chatId, userId, msgChan)
 
 	// Уведомляем всех об обновлении онлайн пользователей
-	go room.BroadcastOnlineUsers()
+	go s.broadcastOnlineUsers(context.Background(), chatId)
 
 	return msgChan, nil
 }
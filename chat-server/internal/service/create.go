@@ -19,7 +19,7 @@ func (e *ErrUserNotFound) Error() string {
 	return fmt.Sprintf("users not found: %s", strings.Join(e.Usernames, ","))
 }
 
-func (s *service) Create(ctx context.Context, name string, isPublic bool, creatorId int64, creatorUsername string, usernames []string) (int64, error) {
+func (s *service) Create(ctx context.Context, name string, isPublic bool, joinApproval bool, creatorId int64, creatorUsername string, usernames []string) (int64, error) {
 	// Проверяем что Usernames не пусто
 	if len(usernames) == 0 {
 		return 0, errors.New("usernames cannot be empty")
@@ -73,6 +73,8 @@ func (s *service) Create(ctx context.Context, name string, isPublic bool, creato
 		IsPublic:  isPublic,
 		CreatorId: creatorId,
 		Members:   members,
+
+		JoinApproval: joinApproval,
 	}
 
 	// Создаем чат с существующими участниками
@@ -89,6 +91,7 @@ func (s *service) Create(ctx context.Context, name string, isPublic bool, creato
 		zap.Int64("chat_id", id),
 		zap.String("name", name),
 		zap.Bool("is_Public", isPublic),
+		zap.Bool("join_approval", joinApproval),
 		zap.Int64("creator_id", creatorId),
 		zap.Int("members_count", len(members)),
 	)
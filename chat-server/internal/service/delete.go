@@ -2,35 +2,223 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
 	"go.uber.org/zap"
 )
 
-func (s *service) Delete(ctx context.Context, id int64) error {
-	err := s.ChatRepository.Delete(ctx, id)
+// purgeBatchSize сколько удаленных чатов стирается за один запрос
+const purgeBatchSize = 50
+
+// Delete удаляет чат. Групповой чат может удалить только владелец, личный - любой из двух участников.
+// Чат сразу пропадает у всех, а стирается вместе с файлами фоновой задачей после окна восстановления.
+// Возвращает, до какого момента чат можно восстановить, нулевое время если окна нет
+func (s *service) Delete(ctx context.Context, userId int64, id int64) (time.Time, error) {
+	chat, err := s.ChatRepository.ChatInfo(ctx, id)
 	if err != nil {
-		logger.Error("failed to delete chat",
-			zap.Int64("chat_id", id),
-			zap.Error(err),
-		)
-		return fmt.Errorf("failed to delete chat: %w", err)
+		if errors.Is(err, repository.ErrNotFound) {
+			return time.Time{}, fmt.Errorf("chat %d: %w", id, ErrChatNotFound)
+		}
+		logger.Error("failed to get chat info", zap.Int64("chat_id", id), zap.Error(err))
+		return time.Time{}, fmt.Errorf("get chat info: %w", err)
+	}
+
+	if err := CheckCanDelete(chat, userId); err != nil {
+		logger.Warn("chat delete is not allowed", zap.Int64("chat_id", id), zap.Int64("user_id", userId), zap.Error(err))
+		return time.Time{}, err
 	}
 
-	// Удаляем записи непрочитанных
-	err = s.UnreadRepository.DeleteForChat(ctx, id)
+	restoreUntil := time.Now().Add(s.restoreWindow)
+	err = s.ChatRepository.Delete(ctx, id, restoreUntil)
 	if err != nil {
-		logger.Error("failed to delete unread for chat",
+		if errors.Is(err, repository.ErrNotFound) {
+			// Чат успели удалить параллельно
+			return time.Time{}, fmt.Errorf("chat %d: %w", id, ErrChatNotFound)
+		}
+		logger.Error("failed to delete chat",
 			zap.Int64("chat_id", id),
 			zap.Error(err),
 		)
+		return time.Time{}, fmt.Errorf("failed to delete chat: %w", err)
 	}
 
+	// Подписчики на всех репликах получат событие и будут отключены
+	s.broadcastMessage(ctx, id, MessageDTO{
+		MessageType: MessageTypeChatDeleted,
+		CreatedAt:   time.Now(),
+	})
+
 	logger.Info(
 		"chat deleted successfully",
 		zap.Int64("chat_id", id),
+		zap.Int64("deleted_by", userId),
+		zap.Time("restore_until", restoreUntil),
 	)
 
+	if s.restoreWindow == 0 {
+		return time.Time{}, nil
+	}
+
+	return restoreUntil, nil
+}
+
+// RestoreChat восстанавливает удаленный чат, пока не истекло окно восстановления. Права те же, что на удаление
+func (s *service) RestoreChat(ctx context.Context, userId int64, id int64) error {
+	chat, err := s.ChatRepository.DeletedChat(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("chat %d is not deleted: %w", id, ErrChatNotFound)
+		}
+		logger.Error("failed to get deleted chat", zap.Int64("chat_id", id), zap.Error(err))
+		return fmt.Errorf("get deleted chat: %w", err)
+	}
+
+	if err := CheckCanDelete(chat, userId); err != nil {
+		logger.Warn("chat restore is not allowed", zap.Int64("chat_id", id), zap.Int64("user_id", userId), zap.Error(err))
+		return err
+	}
+
+	// Пока личный чат был удален, участники могли начать новый, второй личный чат между ними не нужен
+	if chat.IsDirect && len(chat.Members) == 2 {
+		existing, err := s.ChatRepository.FindDirectChat(ctx, chat.Members[0].UserId, chat.Members[1].UserId)
+		if err != nil {
+			logger.Error("failed to find direct chat", zap.Int64("chat_id", id), zap.Error(err))
+			return fmt.Errorf("find direct chat: %w", err)
+		}
+		if existing != 0 {
+			return fmt.Errorf("direct chat %d already exists: %w", existing, ErrChatNotRestorable)
+		}
+	}
+
+	err = s.ChatRepository.RestoreChat(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("restore window of chat %d expired: %w", id, ErrChatNotRestorable)
+		}
+		logger.Error("failed to restore chat", zap.Int64("chat_id", id), zap.Error(err))
+		return fmt.Errorf("restore chat: %w", err)
+	}
+
+	logger.Info("chat restored", zap.Int64("chat_id", id), zap.Int64("restored_by", userId))
+
 	return nil
 }
+
+// CheckCanDelete проверяет право удалить или восстановить чат: в личном чате у обоих участников равные права,
+// в групповом это может только владелец. Передать это право ролью нельзя
+func CheckCanDelete(chat *repository.ChatInfoDTO, userId int64) error {
+	for _, m := range chat.Members {
+		if m.UserId != userId {
+			continue
+		}
+		if chat.IsDirect || m.Role == RoleOwner {
+			return nil
+		}
+		return fmt.Errorf("role %q cannot delete chat: %w", m.Role, ErrPermissionDenied)
+	}
+
+	return fmt.Errorf("user %d not in chat %d: %w", userId, chat.Id, ErrPermissionDenied)
+}
+
+// closeRoom отключает всех подписчиков чата и его тредов на этой реплике
+func (s *service) closeRoom(chatId int64) {
+	// На треды можно подписаться без подписки на чат, поэтому закрываем их до проверки комнаты чата
+	s.closeThreadRooms(chatId)
+
+	s.roomsMu.Lock()
+	room, exists := s.rooms[chatId]
+	delete(s.rooms, chatId)
+	s.roomsMu.Unlock()
+
+	if !exists {
+		return
+	}
+
+	for _, sub := range room.RemoveAll() {
+		close(sub.Channel)
+		s.stopTyping(typingKey{chatId: chatId, userId: sub.UserId})
+
+		err := s.BroadcastBus.RemoveOnline(context.Background(), chatId, sub.UserId)
+		if err != nil {
+			logger.Warn("failed to remove online user", zap.Int64("chat_id", chatId), zap.Int64("user_id", sub.UserId), zap.Error(err))
+		}
+	}
+
+	logger.Info("chat room closed", zap.Int64("chat_id", chatId))
+}
+
+// purgeDeletedChats стирает чаты с истекшим окном восстановления и удаляет их файлы из хранилища
+func (s *service) purgeDeletedChats(ctx context.Context) {
+	for ctx.Err() == nil {
+		purged, err := s.ChatRepository.PurgeDeletedChats(ctx, purgeBatchSize)
+		if err != nil {
+			logger.Error("failed to purge deleted chats", zap.Error(err))
+		}
+		if len(purged) == 0 {
+			return
+		}
+
+		for _, chat := range purged {
+			logger.Info("deleted chat purged", zap.Int64("chat_id", chat.Id), zap.Int("files", len(chat.FileUrls)))
+
+			err := s.UnreadRepository.DeleteForChat(ctx, chat.Id)
+			if err != nil {
+				logger.Error("failed to delete unread for chat",
+					zap.Int64("chat_id", chat.Id),
+					zap.Error(err),
+				)
+			}
+
+			s.removeChatFiles(ctx, chat)
+		}
+
+		if len(purged) < purgeBatchSize || err != nil {
+			return
+		}
+	}
+}
+
+// removeChatFiles удаляет файлы стертого чата: вложения его сообщений и все загруженное в хранилище под этот чат,
+// в том числе файлы, которые так и не отправили. Файлы, на которые ссылаются пересланные копии в других чатах, остаются
+func (s *service) removeChatFiles(ctx context.Context, chat repository.PurgedChatDTO) {
+	urls := chat.FileUrls
+
+	// Так web-gateway называет файлы чата при загрузке
+	for _, prefix := range []string{fmt.Sprintf("chat/%d/", chat.Id), fmt.Sprintf("voice/%d_", chat.Id)} {
+		stored, err := s.FileStorage.List(ctx, prefix)
+		if err != nil {
+			logger.Warn("failed to list chat files", zap.Int64("chat_id", chat.Id), zap.String("prefix", prefix), zap.Error(err))
+			continue
+		}
+		urls = append(urls, stored...)
+	}
+	if len(urls) == 0 {
+		return
+	}
+
+	inUse, err := s.ChatRepository.FileUrlsInUse(ctx, urls)
+	if err != nil {
+		logger.Error("failed to check files in use", zap.Int64("chat_id", chat.Id), zap.Error(err))
+		return
+	}
+
+	unused := make([]string, 0, len(urls))
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		if !inUse[url] && !seen[url] {
+			unused = append(unused, url)
+		}
+		seen[url] = true
+	}
+	if len(unused) == 0 {
+		return
+	}
+
+	if err := s.FileStorage.Remove(ctx, unused); err != nil {
+		logger.Warn("failed to remove chat files", zap.Int64("chat_id", chat.Id), zap.Int("count", len(unused)), zap.Error(err))
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// DeleteMessage удаляет сообщение для себя или для всех участников чата
+func (s *service) DeleteMessage(ctx context.Context, userId int64, messageId int64, forEveryone bool) error {
+	msg, err := s.ChatRepository.MessageById(ctx, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			logger.Warn("message not found", zap.Int64("message_id", messageId))
+			return ErrMessageNotFound
+		}
+		logger.Error("failed to get message", zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("get message: %w", err)
+	}
+
+	// Проверяем что пользователь в чате, иначе сообщение для него не существует
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, msg.ChatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", userId), zap.Error(err))
+		return fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", userId))
+		return ErrMessageNotFound
+	}
+
+	event := MessageDTO{
+		Id:          messageId,
+		MessageType: MessageTypeDeleted,
+		From:        msg.From,
+		CreatedAt:   time.Now(),
+	}
+	if msg.ThreadRootId != nil {
+		event.ThreadRootId = *msg.ThreadRootId
+	}
+
+	if forEveryone {
+		// Удалить для всех может автор или роль с правом удалять чужие сообщения
+		if msg.UserId != userId {
+			if _, err := s.checkPermission(ctx, msg.ChatId, userId, PermDeleteMessages); err != nil {
+				return err
+			}
+		}
+
+		err = s.ChatRepository.DeleteMessageForEveryone(ctx, messageId)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrMessageNotFound
+			}
+			logger.Error("failed to delete message", zap.Int64("message_id", messageId), zap.Error(err))
+			return fmt.Errorf("delete message: %w", err)
+		}
+	} else {
+		err = s.ChatRepository.HideMessage(ctx, messageId, userId)
+		if err != nil {
+			logger.Error("failed to hide message", zap.Int64("message_id", messageId), zap.Int64("user_id", userId), zap.Error(err))
+			return fmt.Errorf("hide message: %w", err)
+		}
+
+		// Остальные участники продолжают видеть сообщение
+		event.ToUserId = userId
+	}
+
+	logger.Info("message deleted", zap.Int64("chat_id", msg.ChatId), zap.Int64("message_id", messageId), zap.Bool("for_everyone", forEveryone))
+
+	s.broadcastMessage(ctx, msg.ChatId, event)
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
 	"go.uber.org/zap"
 )
@@ -21,14 +23,22 @@ func (s *service) DisconnectFromChat(chatId int64, userId int64) {
 	// Закрываем канал подписчика
 	close(channel)
 
+	// Если пользователь печатал, сразу убираем индикатор
+	s.stopTyping(typingKey{chatId: chatId, userId: userId})
+
+	err := s.BroadcastBus.RemoveOnline(context.Background(), chatId, userId)
+	if err != nil {
+		logger.Warn("failed to remove online user", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+	}
+
 	logger.Info("subscriber disconnected", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Int("remaining_online", room.GetOnlineUsersCount()))
 
 	// Если комната пуста, то удаляем ее
 	if isEmpty {
 		s.deleteRoomIfEmpty(chatId)
 		logger.Debug("no subscribers left in chat", zap.Int64("chat_id", chatId))
-	} else {
-		// Если не пустая, то уведомляем об актуальном онлайне в чате
-		go room.BroadcastOnlineUsers()
 	}
+
+	// Уведомляем об актуальном онлайне в чате, подписчики могут быть на других репликах
+	go s.broadcastOnlineUsers(context.Background(), chatId)
 }
@@ -3,11 +3,24 @@ package service
 import "time"
 
 const (
-	MessageTypeText        = 0
-	MessageTypeOnlineUsers = 1
-	MessageTypeVoice       = 2
-	MessageTypeImage       = 3
-	MessageTypeFile        = 4
+	MessageTypeText          = 0
+	MessageTypeOnlineUsers   = 1
+	MessageTypeVoice         = 2
+	MessageTypeImage         = 3
+	MessageTypeFile          = 4
+	MessageTypeEdited        = 5  // Событие редактирования, не сохраняется как отдельное сообщение
+	MessageTypeDeleted       = 6  // Событие удаления, клиент убирает сообщение с таким Id
+	MessageTypeThreadUpdated = 7  // Событие для ленты чата: у корня треда с таким Id изменились счетчик и время последнего ответа
+	MessageTypeReaction      = 8  // Событие изменения реакции на сообщении с таким Id
+	MessageTypeTyping        = 9  // Пользователь печатает, не сохраняется
+	MessageTypeReadReceipt   = 10 // Участник сдвинул указатель прочтения, детали в ReadReceipt
+	MessageTypePin           = 11 // Сообщение с таким Id закрепили или открепили, детали в Pin
+	MessageTypeExpired       = 12 // Сообщение с таким Id истекло и удалено, клиент убирает его без заглушки
+	MessageTypePoll          = 13 // Опрос, вопрос в Text, варианты и голоса в Poll
+	MessageTypePollUpdated   = 14 // Событие опроса с таким Id: изменились голоса или опрос закрыли, состояние в Poll
+	MessageTypeLinkPreview   = 15 // Для сообщения с таким Id загрузилось превью ссылки, детали в LinkPreview
+	MessageTypeChatDeleted   = 16 // Чат удален, после события реплика отключает всех подписчиков чата
+	MessageTypeMemberLeft    = 17 // Участник вышел из чата, детали в MemberLeft. После события реплика отключает его подписку
 )
 
 type SendMessageDTO struct {
@@ -20,9 +33,16 @@ type SendMessageDTO struct {
 	FileUrl       string
 	FileName      string
 	FileSize      int64
+	ReplyToId     int64             // 0 если это не ответ
+	ThreadRootId  int64             // 0 если сообщение в ленту чата
+	ForwardedFrom *ForwardedFromDTO // nil если сообщение не пересланное
+	Poll          *PollCreateDTO    // Только для MessageTypePoll
+	ClientKey     string            // Ключ идемпотентности, пустой если клиент его не передал
 }
 
 type MessageDTO struct {
+	Id            int64
+	Seq           int64 // Порядковый номер в чате, 0 для событий
 	MessageType   int32
 	From          string
 	Text          string
@@ -32,6 +52,221 @@ type MessageDTO struct {
 	FileUrl       string
 	FileName      string
 	FileSize      int64
+	EditedAt      time.Time         // Нулевое значение, если сообщение не редактировалось
+	Deleted       bool              // Заглушка удаленного для всех сообщения в истории
+	ReplyTo       *ReplyPreviewDTO  // Превью цитаты, если это ответ
+	ToUserId      int64             // Если задан, то событие получит только этот пользователь
+	ForwardedFrom *ForwardedFromDTO // Источник, если сообщение переслано
+	Mentions      []int64           // id упомянутых участников, заполняется при отправке
+	ExpiresAt     time.Time         // Когда сообщение исчезнет, нулевое значение если не исчезает
+	Duplicate     bool              // Повторная отправка с тем же ключом, возвращено ранее сохраненное сообщение
+
+	ThreadRootId      int64     // Если задан, то сообщение относится к треду и уходит подписчикам треда
+	ThreadReplyCount  int32     // Для корня треда
+	ThreadLastReplyAt time.Time // Для корня треда, нулевое значение если ответов нет
+
+	Reactions []ReactionDTO     // Сводка реакций, заполняется при загрузке истории
+	Reaction  *ReactionEventDTO // Используется только когда у нас тип MessageTypeReaction
+	Typing    *TypingDTO        // Используется только когда у нас тип MessageTypeTyping
+
+	ReadReceipt *ReadReceiptDTO // Используется только когда у нас тип MessageTypeReadReceipt
+	Pin         *PinEventDTO    // Используется только когда у нас тип MessageTypePin
+	Poll        *PollDTO        // Для опроса и события MessageTypePollUpdated
+	LinkPreview *LinkPreviewDTO // Превью ссылки из текста, в истории и в событии MessageTypeLinkPreview
+	MemberLeft  *MemberLeftDTO  // Используется только когда у нас тип MessageTypeMemberLeft
+}
+
+// LinkPreviewDTO превью ссылки: заголовок, описание и картинка страницы
+type LinkPreviewDTO struct {
+	Url         string
+	Title       string
+	Description string
+	ImageUrl    string
+	SiteName    string
+}
+
+// ScheduledMessageDTO отложенное сообщение пользователя
+type ScheduledMessageDTO struct {
+	Id            int64
+	ChatId        int64
+	MessageType   int32
+	Text          string
+	VoiceDuration float32
+	FileUrl       string
+	FileName      string
+	FileSize      int64
+	ReplyToId     int64
+	ThreadRootId  int64
+	SendAt        time.Time
+	CreatedAt     time.Time
+	Attempts      int32
+	LastError     string // Причина последней неудачной попытки отправки
+	Failed        bool   // Попытки закончились, сообщение не будет отправлено
+}
+
+// InviteDTO приглашение в чат. Code заполнен только сразу после создания, дальше известен лишь CodeHint
+type InviteDTO struct {
+	Id        int64
+	ChatId    int64
+	Code      string
+	CodeHint  string
+	CreatedBy int64
+	MaxUses   int32     // 0 - без ограничения
+	Uses      int32     // Сколько пользователей вступило по приглашению
+	ExpiresAt time.Time // Нулевое время - бессрочно
+	CreatedAt time.Time
+	Revoked   bool
+}
+
+// InvitePreviewDTO чат, в который ведет приглашение
+type InvitePreviewDTO struct {
+	ChatId      int64
+	Name        string
+	MemberCount int32
+	IsMember    bool
+}
+
+// JoinRequestDTO заявка на вступление в чат
+type JoinRequestDTO struct {
+	Id        int64
+	ChatId    int64
+	UserId    int64
+	Username  string
+	CreatedAt time.Time
+}
+
+// JoinRequestDecisionDTO решение по заявке, ChatName нужен для уведомления заявителя
+type JoinRequestDecisionDTO struct {
+	RequestId int64
+	ChatId    int64
+	ChatName  string
+	UserId    int64
+	Approved  bool
+}
+
+// ForwardedFromDTO источник пересланного сообщения
+type ForwardedFromDTO struct {
+	From      string // Автор исходного сообщения
+	ChatId    int64
+	MessageId int64 // 0 если исходное сообщение удалено
+}
+
+// ForwardedMessageDTO сообщение, созданное пересылкой в чате ChatId
+type ForwardedMessageDTO struct {
+	ChatId  int64
+	Message MessageDTO
+}
+
+// PollCreateDTO параметры нового опроса, вопрос передается текстом сообщения
+type PollCreateDTO struct {
+	Options        []string
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       time.Time // Нулевое значение, если опрос закрывается только вручную
+}
+
+// PollDTO опрос с голосами. VotedByMe заполняется только в ответах конкретному пользователю,
+// в рассылке клиент определяет свой голос по VoterIds или по ответу на VotePoll
+type PollDTO struct {
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       time.Time
+	Closed         bool
+	TotalVoters    int32
+	Options        []PollOptionDTO
+}
+
+// PollOptionDTO вариант ответа опроса
+type PollOptionDTO struct {
+	Id        int64
+	Text      string
+	Votes     int32
+	VotedByMe bool
+	VoterIds  []int64 // Пусто в анонимном опросе
+}
+
+// PinEventDTO изменение закрепа: кто закрепил или открепил и превью сообщения
+type PinEventDTO struct {
+	UserId   int64
+	Username string
+	Pinned   bool // false - сообщение открепили
+	Message  *ReplyPreviewDTO
+}
+
+// PinnedMessageDTO закрепленное сообщение
+type PinnedMessageDTO struct {
+	Message          MessageDTO
+	PinnedBy         int64
+	PinnedByUsername string
+	PinnedAt         time.Time
+}
+
+// ReadReceiptDTO участник прочитал ленту чата до сообщения LastReadMessageId включительно
+type ReadReceiptDTO struct {
+	UserId            int64
+	Username          string
+	LastReadMessageId int64
+	ReadAt            time.Time
+}
+
+// TypingDTO состояние набора текста, после ExpiresAt клиент считает что пользователь перестал печатать
+type TypingDTO struct {
+	UserId    int64
+	Username  string
+	IsTyping  bool
+	ExpiresAt time.Time
+}
+
+// MemberLeftDTO участник вышел из чата, NewOwnerId - новый владелец, если вышел владелец
+type MemberLeftDTO struct {
+	UserId     int64
+	Username   string
+	NewOwnerId int64
+}
+
+// ReactionDTO сводка по одному эмодзи на сообщении
+type ReactionDTO struct {
+	Emoji       string
+	Count       int32
+	ReactedByMe bool
+}
+
+// ReactionEventDTO изменение реакции, ReactedByMe клиент определяет сам по UserId
+type ReactionEventDTO struct {
+	Emoji    string
+	UserId   int64
+	Username string
+	Added    bool  // false - реакцию сняли
+	Count    int32 // Сколько реакций с этим эмодзи стало
+}
+
+// ReplyPreviewDTO превью сообщения, на которое отвечают
+type ReplyPreviewDTO struct {
+	MessageId   int64
+	From        string
+	Text        string
+	MessageType int32
+	Deleted     bool
+}
+
+// SearchMessagesDTO параметры поиска сообщений, пустые поля не фильтруют
+type SearchMessagesDTO struct {
+	Query        string
+	ChatId       int64
+	FromUsername string
+	MessageType  *int32
+	DateFrom     *time.Time
+	DateTo       *time.Time
+	BeforeId     int64 // Курсор: id последнего полученного результата
+	Limit        int
+}
+
+// SearchResultDTO найденное сообщение, Snippet экранирован и совпадения обернуты в <mark>
+type SearchResultDTO struct {
+	ChatId   int64
+	ChatName string
+	Snippet  string
+	Message  MessageDTO
 }
 
 type ChatInfoDTO struct {
@@ -39,14 +274,18 @@ type ChatInfoDTO struct {
 	Name              string
 	Usernames         []string
 	MemberIds         []int64
+	MemberRoles       map[int64]string // Роль участника по user_id
 	IsDirect          bool
 	IsPublic          bool
+	JoinApproval      bool // Вступление в открытый чат через заявку
 	CreatorId         int64
 	CreatedAt         time.Time
 	LastMessage       string
 	LastMessageSender string
 	LastMessageAt     time.Time
 	UnreadCount       int32
+	PinnedMessage     *ReplyPreviewDTO // Последнее закрепленное сообщение
+	MessageTTL        time.Duration    // Время жизни новых сообщений, 0 - сообщения не исчезают
 }
 
 // OnlineUserDTO информация о подключенном пользователе
@@ -62,9 +301,10 @@ type FriendPresenceDTO struct {
 }
 
 type PublicChatDTO struct {
-	Id          int64
-	Name        string
-	MemberCount int
-	CreatorName string
-	CreatedAt   time.Time
+	Id           int64
+	Name         string
+	MemberCount  int
+	CreatorName  string
+	CreatedAt    time.Time
+	JoinApproval bool
 }
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// EditMessage меняет текст сообщения, предыдущая версия сохраняется в истории правок
+func (s *service) EditMessage(ctx context.Context, userId int64, messageId int64, text string) error {
+	msg, err := s.ChatRepository.MessageById(ctx, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			logger.Warn("message not found", zap.Int64("message_id", messageId))
+			return ErrMessageNotFound
+		}
+		logger.Error("failed to get message", zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("get message: %w", err)
+	}
+
+	// Удаленное для всех сообщение больше не существует для клиентов
+	if msg.DeletedAt != nil {
+		return ErrMessageNotFound
+	}
+
+	// Редактировать может только автор
+	if msg.UserId != userId {
+		logger.Warn("only author can edit message", zap.Int64("message_id", messageId), zap.Int64("user_id", userId))
+		return fmt.Errorf("only author can edit message: %w", ErrPermissionDenied)
+	}
+
+	// Автор, которому больше нельзя писать в чат, не может и править свои сообщения
+	if _, err := s.checkPermission(ctx, msg.ChatId, userId, PermSendMessages); err != nil {
+		return err
+	}
+
+	// У голосового сообщения нечего редактировать, а вопрос опроса нельзя менять после начала голосования
+	if msg.MessageType == "voice" || msg.MessageType == "poll" {
+		return fmt.Errorf("%s message cannot be edited: %w", msg.MessageType, ErrPermissionDenied)
+	}
+
+	if s.editWindow > 0 && time.Since(msg.CreatedAt) > s.editWindow {
+		logger.Warn("edit window expired", zap.Int64("message_id", messageId), zap.Time("created_at", msg.CreatedAt))
+		return ErrEditWindowExpired
+	}
+
+	editedAt, err := s.ChatRepository.EditMessage(ctx, messageId, userId, text)
+	if err != nil {
+		logger.Error("failed to edit message", zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("edit message: %w", err)
+	}
+
+	logger.Info("message edited", zap.Int64("chat_id", msg.ChatId), zap.Int64("message_id", messageId))
+
+	// Рассылаем событие, чтобы клиенты обновили текст
+	event := MessageDTO{
+		Id:          messageId,
+		MessageType: MessageTypeEdited,
+		From:        msg.From,
+		Text:        text,
+		CreatedAt:   msg.CreatedAt,
+		EditedAt:    editedAt,
+	}
+	if msg.ThreadRootId != nil {
+		event.ThreadRootId = *msg.ThreadRootId
+	}
+
+	s.broadcastMessage(ctx, msg.ChatId, event)
+
+	// Старое превью удалено вместе с правкой, ссылка в новом тексте может быть другой
+	if msg.MessageType == "text" {
+		s.unfurlLink(msg.ChatId, event)
+	}
+
+	return nil
+}
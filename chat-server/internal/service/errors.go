@@ -0,0 +1,18 @@
+package service
+
+import "errors"
+
+var (
+	ErrMessageNotFound     = errors.New("message not found")
+	ErrPermissionDenied    = errors.New("permission denied")
+	ErrEditWindowExpired   = errors.New("message can no longer be edited")
+	ErrPollClosed          = errors.New("poll is closed")
+	ErrInvalidPollVote     = errors.New("invalid poll vote")
+	ErrMemberNotFound      = errors.New("member not found")
+	ErrInvalidRole         = errors.New("invalid role")
+	ErrDirectChat          = errors.New("not allowed in direct chat")
+	ErrChatNotFound        = errors.New("chat not found")
+	ErrChatNotRestorable   = errors.New("chat cannot be restored")
+	ErrInviteNotFound      = errors.New("invite not found or no longer valid")
+	ErrJoinRequestNotFound = errors.New("join request not found or already resolved")
+)
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ForwardMessages пересылает сообщения из исходного чата в каждый из целевых.
+// Пересланные сообщения проходят тот же путь, что и обычные: рассылка подписчикам и непрочитанные.
+// Копии отправляются по одной, поэтому с clientKey у каждой свой ключ идемпотентности: повтор после
+// частичной ошибки досылает оставшиеся копии, не дублируя уже созданные
+func (s *service) ForwardMessages(ctx context.Context, userId int64, username string, sourceChatId int64, messageIds []int64, targetChatIds []int64, clientKey string) ([]ForwardedMessageDTO, error) {
+	// Пользователь должен состоять в исходном чате и иметь право писать во все целевые
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, sourceChatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", sourceChatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", sourceChatId), zap.Int64("user_id", userId))
+		return nil, fmt.Errorf("user %d not in chat %d: %w", userId, sourceChatId, ErrPermissionDenied)
+	}
+
+	for _, chatId := range targetChatIds {
+		if _, err := s.checkPermission(ctx, chatId, userId, PermSendMessages); err != nil {
+			return nil, err
+		}
+	}
+
+	originals, err := s.ChatRepository.MessagesByIds(ctx, sourceChatId, messageIds, userId)
+	if err != nil {
+		logger.Error("failed to load messages to forward", zap.Int64("chat_id", sourceChatId), zap.Error(err))
+		return nil, fmt.Errorf("load messages: %w", err)
+	}
+	if len(originals) == 0 {
+		return nil, fmt.Errorf("messages in chat %d: %w", sourceChatId, ErrMessageNotFound)
+	}
+
+	// Пересланный опрос - новый опрос с теми же вариантами, голоса не переносятся
+	var pollIds []int64
+	for _, original := range originals {
+		if original.MessageType == "poll" {
+			pollIds = append(pollIds, original.Id)
+		}
+	}
+	polls, err := s.ChatRepository.Polls(ctx, pollIds, userId)
+	if err != nil {
+		logger.Error("failed to load polls to forward", zap.Int64("chat_id", sourceChatId), zap.Error(err))
+		return nil, fmt.Errorf("load polls: %w", err)
+	}
+
+	res := make([]ForwardedMessageDTO, 0, len(originals)*len(targetChatIds))
+	for _, targetChatId := range targetChatIds {
+		for _, original := range originals {
+			// Повторная пересылка сохраняет самый первый источник
+			forwardedFrom := &ForwardedFromDTO{
+				From:      original.From,
+				ChatId:    original.ChatId,
+				MessageId: original.Id,
+			}
+			if original.ForwardedFrom != nil {
+				forwardedFrom = &ForwardedFromDTO{
+					From:   original.ForwardedFrom.From,
+					ChatId: original.ForwardedFrom.ChatId,
+				}
+				if original.ForwardedFrom.MessageId != nil {
+					forwardedFrom.MessageId = *original.ForwardedFrom.MessageId
+				}
+			}
+
+			var pollCreate *PollCreateDTO
+			if poll, ok := polls[original.Id]; ok {
+				pollCreate = forwardedPoll(poll)
+			}
+
+			var copyKey string
+			if clientKey != "" {
+				copyKey = uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("forward:%s:%d:%d", clientKey, targetChatId, original.Id))).String()
+			}
+
+			sent, err := s.SendMessage(ctx, SendMessageDTO{
+				ChatId:        targetChatId,
+				UserId:        userId,
+				FromUsername:  username,
+				Text:          original.Text,
+				MessageType:   messageTypeFromDB(original.MessageType),
+				VoiceDuration: original.VoiceDuration,
+				FileUrl:       original.FileUrl,
+				FileName:      original.FileName,
+				FileSize:      original.FileSize,
+				ForwardedFrom: forwardedFrom,
+				Poll:          pollCreate,
+				ClientKey:     copyKey,
+			})
+			if err != nil {
+				return res, fmt.Errorf("forward message %d to chat %d: %w", original.Id, targetChatId, err)
+			}
+
+			res = append(res, ForwardedMessageDTO{ChatId: targetChatId, Message: sent})
+		}
+	}
+
+	logger.Info("messages forwarded", zap.Int64("source_chat_id", sourceChatId), zap.Int64("user_id", userId), zap.Int("count", len(res)))
+
+	return res, nil
+}
+
+// forwardedPoll параметры копии опроса. Уже наступившее время закрытия не переносится
+func forwardedPoll(poll repository.PollDTO) *PollCreateDTO {
+	res := &PollCreateDTO{
+		MultipleChoice: poll.MultipleChoice,
+		Anonymous:      poll.Anonymous,
+	}
+	if poll.ClosesAt != nil && poll.ClosesAt.After(time.Now()) {
+		res.ClosesAt = *poll.ClosesAt
+	}
+	for _, option := range poll.Options {
+		res.Options = append(res.Options, option.Text)
+	}
+
+	return res
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 100
+)
+
+// GetHistory возвращает страницу истории чата и флаг, есть ли сообщения дальше в выбранном направлении
+func (s *service) GetHistory(ctx context.Context, userId int64, chatId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, false, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId))
+		return nil, false, fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+	}
+
+	messages, hasMore, err := s.ChatRepository.History(ctx, chatId, userId, beforeId, afterId, limit)
+	if err != nil {
+		logger.Error("failed to load chat history", zap.Int64("chat_id", chatId), zap.Error(err))
+		return nil, false, fmt.Errorf("load history: %w", err)
+	}
+
+	res := make([]MessageDTO, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, toMessageDTO(msg))
+	}
+
+	s.attachReactions(ctx, userId, res)
+	s.attachPolls(ctx, userId, res)
+
+	return res, hasMore, nil
+}
+
+// GetHistoryAround возвращает страницу ленты чата вокруг сообщения и флаги, есть ли сообщения старше и новее.
+// Нужен для перехода к сообщению из поиска
+func (s *service) GetHistoryAround(ctx context.Context, userId int64, chatId int64, messageId int64, limit int) ([]MessageDTO, bool, bool, error) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, false, false, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId))
+		return nil, false, false, fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+	}
+
+	messages, hasOlder, hasNewer, err := s.ChatRepository.HistoryAround(ctx, chatId, userId, messageId, limit)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, false, fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+		}
+		logger.Error("failed to load chat history around message", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+		return nil, false, false, fmt.Errorf("load history around message: %w", err)
+	}
+
+	res := make([]MessageDTO, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, toMessageDTO(msg))
+	}
+
+	s.attachReactions(ctx, userId, res)
+	s.attachPolls(ctx, userId, res)
+
+	return res, hasOlder, hasNewer, nil
+}
+
+// GetMessagesBySeq возвращает сообщения чата из диапазона порядковых номеров, чтобы клиент закрыл пропуск после переподключения
+func (s *service) GetMessagesBySeq(ctx context.Context, userId int64, chatId int64, fromSeq int64, toSeq int64) ([]MessageDTO, error) {
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId))
+		return nil, fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+	}
+
+	messages, err := s.ChatRepository.MessagesBySeq(ctx, chatId, userId, fromSeq, toSeq)
+	if err != nil {
+		logger.Error("failed to load messages by seq", zap.Int64("chat_id", chatId), zap.Int64("from_seq", fromSeq), zap.Int64("to_seq", toSeq), zap.Error(err))
+		return nil, fmt.Errorf("load messages by seq: %w", err)
+	}
+
+	res := make([]MessageDTO, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, toMessageDTO(msg))
+	}
+
+	s.attachReactions(ctx, userId, res)
+	s.attachPolls(ctx, userId, res)
+
+	return res, nil
+}
+
+// messageTypeToDB конвертирует тип сообщения клиента в тип для БД
+func messageTypeToDB(msgType int32) string {
+	switch msgType {
+	case MessageTypeVoice:
+		return "voice"
+	case MessageTypeImage:
+		return "image"
+	case MessageTypeFile:
+		return "file"
+	case MessageTypePoll:
+		return "poll"
+	}
+	return "text"
+}
+
+// messageTypeFromDB конвертирует тип сообщения из БД в тип для клиента
+func messageTypeFromDB(msgType string) int32 {
+	switch msgType {
+	case "voice":
+		return MessageTypeVoice
+	case "image":
+		return MessageTypeImage
+	case "file":
+		return MessageTypeFile
+	case "poll":
+		return MessageTypePoll
+	}
+	return MessageTypeText
+}
+
+// toMessageDTO конвертирует сообщение из БД в сообщение для клиента
+func toMessageDTO(msg repository.MessageDTO) MessageDTO {
+	res := MessageDTO{
+		Id:            msg.Id,
+		Seq:           msg.Seq,
+		MessageType:   messageTypeFromDB(msg.MessageType),
+		From:          msg.From,
+		Text:          msg.Text,
+		CreatedAt:     msg.CreatedAt,
+		VoiceDuration: msg.VoiceDuration,
+		FileUrl:       msg.FileUrl,
+		FileName:      msg.FileName,
+		FileSize:      msg.FileSize,
+	}
+	if msg.EditedAt != nil {
+		res.EditedAt = *msg.EditedAt
+	}
+	// Удаленное для всех сообщение отдаем заглушкой на своем месте
+	if msg.DeletedAt != nil {
+		res.Deleted = true
+	}
+	if msg.ThreadRootId != nil {
+		res.ThreadRootId = *msg.ThreadRootId
+	}
+	res.ThreadReplyCount = msg.ThreadReplyCount
+	if msg.ThreadLastReplyAt != nil {
+		res.ThreadLastReplyAt = *msg.ThreadLastReplyAt
+	}
+	if msg.ExpiresAt != nil {
+		res.ExpiresAt = *msg.ExpiresAt
+	}
+	if msg.ForwardedFrom != nil {
+		res.ForwardedFrom = &ForwardedFromDTO{
+			From:   msg.ForwardedFrom.From,
+			ChatId: msg.ForwardedFrom.ChatId,
+		}
+		if msg.ForwardedFrom.MessageId != nil {
+			res.ForwardedFrom.MessageId = *msg.ForwardedFrom.MessageId
+		}
+	}
+	// У удаленного сообщения превью не показываем, даже если строка превью еще не стерта
+	if msg.LinkPreview != nil && msg.DeletedAt == nil {
+		res.LinkPreview = &LinkPreviewDTO{
+			Url:         msg.LinkPreview.Url,
+			Title:       msg.LinkPreview.Title,
+			Description: msg.LinkPreview.Description,
+			ImageUrl:    msg.LinkPreview.ImageUrl,
+			SiteName:    msg.LinkPreview.SiteName,
+		}
+	}
+	if msg.ReplyTo != nil {
+		res.ReplyTo = &ReplyPreviewDTO{
+			MessageId:   msg.ReplyTo.MessageId,
+			From:        msg.ReplyTo.From,
+			Text:        msg.ReplyTo.Text,
+			MessageType: messageTypeFromDB(msg.ReplyTo.MessageType),
+			Deleted:     msg.ReplyTo.Deleted,
+		}
+	}
+
+	return res
+}
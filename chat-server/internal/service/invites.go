@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	inviteCodeBytes = 16 // 128 бит случайности, в base64url это 22 символа
+	inviteHintLen   = 4  // Сколько первых символов кода хранится открыто для списка приглашений
+)
+
+// CreateInvite создает ссылку-приглашение в групповой чат. Создавать приглашения могут роли с правом добавлять участников.
+// Код возвращается только здесь, в БД хранится его хеш
+func (s *service) CreateInvite(ctx context.Context, userId int64, chatId int64, maxUses int32, expiresAt time.Time) (InviteDTO, error) {
+	chat, err := s.ChatRepository.ChatInfo(ctx, chatId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return InviteDTO{}, fmt.Errorf("chat %d: %w", chatId, ErrChatNotFound)
+		}
+		logger.Error("failed to get chat info", zap.Int64("chat_id", chatId), zap.Error(err))
+		return InviteDTO{}, fmt.Errorf("get chat info: %w", err)
+	}
+	if chat.IsDirect {
+		return InviteDTO{}, fmt.Errorf("chat %d is direct: %w", chatId, ErrDirectChat)
+	}
+
+	if _, err := s.checkPermission(ctx, chatId, userId, PermAddMembers); err != nil {
+		return InviteDTO{}, err
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		logger.Error("failed to generate invite code", zap.Error(err))
+		return InviteDTO{}, fmt.Errorf("generate invite code: %w", err)
+	}
+
+	create := repository.InviteCreateDTO{
+		ChatId:    chatId,
+		CodeHash:  hashInviteCode(code),
+		CodeHint:  code[:inviteHintLen],
+		CreatedBy: userId,
+		MaxUses:   maxUses,
+	}
+	if !expiresAt.IsZero() {
+		create.ExpiresAt = &expiresAt
+	}
+
+	created, err := s.ChatRepository.CreateInvite(ctx, create)
+	if err != nil {
+		logger.Error("failed to create invite", zap.Int64("chat_id", chatId), zap.Error(err))
+		return InviteDTO{}, fmt.Errorf("create invite: %w", err)
+	}
+
+	logger.Info("invite created", zap.Int64("chat_id", chatId), zap.Int64("invite_id", created.Id), zap.Int64("created_by", userId), zap.Int32("max_uses", maxUses))
+
+	invite := toInviteDTO(created)
+	invite.Code = code
+
+	return invite, nil
+}
+
+// RevokeInvite отзывает приглашение. Право то же, что на создание
+func (s *service) RevokeInvite(ctx context.Context, userId int64, inviteId int64) error {
+	invite, err := s.ChatRepository.InviteById(ctx, inviteId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("invite %d: %w", inviteId, ErrInviteNotFound)
+		}
+		logger.Error("failed to get invite", zap.Int64("invite_id", inviteId), zap.Error(err))
+		return fmt.Errorf("get invite: %w", err)
+	}
+
+	if _, err := s.checkPermission(ctx, invite.ChatId, userId, PermAddMembers); err != nil {
+		return err
+	}
+
+	err = s.ChatRepository.RevokeInvite(ctx, inviteId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("invite %d already revoked: %w", inviteId, ErrInviteNotFound)
+		}
+		logger.Error("failed to revoke invite", zap.Int64("invite_id", inviteId), zap.Error(err))
+		return fmt.Errorf("revoke invite: %w", err)
+	}
+
+	logger.Info("invite revoked", zap.Int64("chat_id", invite.ChatId), zap.Int64("invite_id", inviteId), zap.Int64("revoked_by", userId))
+
+	return nil
+}
+
+// ListInvites возвращает все приглашения чата, включая отозванные и исчерпанные
+func (s *service) ListInvites(ctx context.Context, userId int64, chatId int64) ([]InviteDTO, error) {
+	if _, err := s.checkPermission(ctx, chatId, userId, PermAddMembers); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.ChatRepository.ChatInvites(ctx, chatId)
+	if err != nil {
+		logger.Error("failed to get chat invites", zap.Int64("chat_id", chatId), zap.Error(err))
+		return nil, fmt.Errorf("get chat invites: %w", err)
+	}
+
+	res := make([]InviteDTO, 0, len(invites))
+	for _, invite := range invites {
+		res = append(res, toInviteDTO(invite))
+	}
+
+	return res, nil
+}
+
+// InvitePreview показывает, в какой чат ведет приглашение, не вступая в него
+func (s *service) InvitePreview(ctx context.Context, userId int64, code string) (InvitePreviewDTO, error) {
+	preview, err := s.ChatRepository.InvitePreview(ctx, hashInviteCode(code), userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return InvitePreviewDTO{}, ErrInviteNotFound
+		}
+		logger.Error("failed to get invite preview", zap.Error(err))
+		return InvitePreviewDTO{}, fmt.Errorf("get invite preview: %w", err)
+	}
+
+	return InvitePreviewDTO{
+		ChatId:      preview.ChatId,
+		Name:        preview.Name,
+		MemberCount: preview.MemberCount,
+		IsMember:    preview.IsMember,
+	}, nil
+}
+
+// JoinByInvite добавляет пользователя в чат по коду приглашения.
+// Возвращает id чата и true, если пользователь уже был участником
+func (s *service) JoinByInvite(ctx context.Context, userId int64, username string, code string) (int64, bool, error) {
+	chatId, joined, err := s.ChatRepository.UseInvite(ctx, hashInviteCode(code), userId, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			logger.Warn("invalid invite used", zap.Int64("user_id", userId))
+			return 0, false, ErrInviteNotFound
+		}
+		logger.Error("failed to use invite", zap.Int64("user_id", userId), zap.Error(err))
+		return 0, false, fmt.Errorf("use invite: %w", err)
+	}
+
+	if !joined {
+		return chatId, true, nil
+	}
+
+	// Инициализируем unread для нового участника
+	err = s.UnreadRepository.InitForMember(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to init unread for member", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+	}
+
+	logger.Info("user joined chat by invite", zap.Int64("user_id", userId), zap.Int64("chat_id", chatId))
+
+	return chatId, false, nil
+}
+
+// newInviteCode генерирует случайный код приглашения, пригодный для URL
+func newInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashInviteCode хеш кода, по которому приглашение ищется в БД
+func hashInviteCode(code string) []byte {
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
+
+func toInviteDTO(invite repository.InviteDTO) InviteDTO {
+	res := InviteDTO{
+		Id:        invite.Id,
+		ChatId:    invite.ChatId,
+		CodeHint:  invite.CodeHint,
+		CreatedBy: invite.CreatedBy,
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		CreatedAt: invite.CreatedAt,
+		Revoked:   invite.RevokedAt != nil,
+	}
+	if invite.ExpiresAt != nil {
+		res.ExpiresAt = *invite.ExpiresAt
+	}
+
+	return res
+}
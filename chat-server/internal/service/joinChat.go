@@ -9,46 +9,61 @@ import (
 	"go.uber.org/zap"
 )
 
-func (s *service) JoinChat(ctx context.Context, chatId int64, userId int64, username string) error {
+// JoinChat добавляет пользователя в открытый чат. Если в чате вступление по одобрению, вместо этого создается заявка,
+// и возвращается true
+func (s *service) JoinChat(ctx context.Context, chatId int64, userId int64, username string) (bool, error) {
 	// Получаем информацию о чате
 	chat, err := s.ChatRepository.ChatInfo(ctx, chatId)
 	if err != nil {
 		logger.Error("failed to get chat info", zap.Int64("chat_id", chatId), zap.Error(err))
-		return fmt.Errorf("get chat info: %w", err)
+		return false, fmt.Errorf("get chat info: %w", err)
 	}
 
 	if !chat.IsPublic {
 		logger.Warn("cannot join private chat", zap.Int64("chat_id", chatId))
-		return errors.New("cannot join private chat")
+		return false, errors.New("cannot join private chat")
 	}
 
 	if chat.IsDirect {
 		logger.Warn("cannot join direct chat", zap.Int64("chat_id", chatId))
-		return errors.New("cannot join direct chat")
+		return false, errors.New("cannot join direct chat")
 	}
 
 	// Проверяем не участник ли уже в чате
 	for _, m := range chat.Members {
 		if m.UserId == userId {
 			logger.Warn("user already in chat", zap.Int64("user_id", userId), zap.Int64("chat_id", chatId))
-			return errors.New("user already in chat")
+			return false, errors.New("user already in chat")
 		}
 	}
 
+	// Участником пользователь станет, когда админ одобрит заявку
+	if chat.JoinApproval {
+		request, err := s.ChatRepository.CreateJoinRequest(ctx, chatId, userId, username)
+		if err != nil {
+			logger.Error("failed to create join request", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+			return false, fmt.Errorf("create join request: %w", err)
+		}
+
+		logger.Info("join request created", zap.Int64("request_id", request.Id), zap.Int64("user_id", userId), zap.Int64("chat_id", chatId))
+
+		return true, nil
+	}
+
 	err = s.ChatRepository.AddMember(ctx, chatId, userId, username)
 	if err != nil {
 		logger.Error("failed to add member", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
-		return fmt.Errorf("add member: %w", err)
+		return false, fmt.Errorf("add member: %w", err)
 	}
 
 	// Инициализируем unread для нового участника
 	err = s.UnreadRepository.InitForMember(ctx, chatId, userId)
 	if err != nil {
 		logger.Error("failed to init unread for member", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
-		return fmt.Errorf("init unread for member: %w", err)
+		return false, fmt.Errorf("init unread for member: %w", err)
 	}
 
 	logger.Info("User joined chat", zap.Int64("user_id", userId), zap.Int64("chat_id", chatId))
 
-	return nil
+	return false, nil
 }
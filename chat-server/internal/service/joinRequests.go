@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// ListJoinRequests возвращает ожидающие заявки на вступление. Видят их роли с правом добавлять участников
+func (s *service) ListJoinRequests(ctx context.Context, userId int64, chatId int64) ([]JoinRequestDTO, error) {
+	if _, err := s.checkPermission(ctx, chatId, userId, PermAddMembers); err != nil {
+		return nil, err
+	}
+
+	requests, err := s.ChatRepository.PendingJoinRequests(ctx, chatId)
+	if err != nil {
+		logger.Error("failed to get join requests", zap.Int64("chat_id", chatId), zap.Error(err))
+		return nil, fmt.Errorf("get join requests: %w", err)
+	}
+
+	res := make([]JoinRequestDTO, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, JoinRequestDTO{
+			Id:        request.Id,
+			ChatId:    request.ChatId,
+			UserId:    request.UserId,
+			Username:  request.Username,
+			CreatedAt: request.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// ApproveJoinRequest одобряет заявку, заявитель становится участником чата
+func (s *service) ApproveJoinRequest(ctx context.Context, userId int64, requestId int64) (JoinRequestDecisionDTO, error) {
+	return s.resolveJoinRequest(ctx, userId, requestId, true)
+}
+
+// RejectJoinRequest отклоняет заявку. Пользователь может подать новую
+func (s *service) RejectJoinRequest(ctx context.Context, userId int64, requestId int64) (JoinRequestDecisionDTO, error) {
+	return s.resolveJoinRequest(ctx, userId, requestId, false)
+}
+
+func (s *service) resolveJoinRequest(ctx context.Context, userId int64, requestId int64, approve bool) (JoinRequestDecisionDTO, error) {
+	request, err := s.ChatRepository.JoinRequestById(ctx, requestId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return JoinRequestDecisionDTO{}, fmt.Errorf("join request %d: %w", requestId, ErrJoinRequestNotFound)
+		}
+		logger.Error("failed to get join request", zap.Int64("request_id", requestId), zap.Error(err))
+		return JoinRequestDecisionDTO{}, fmt.Errorf("get join request: %w", err)
+	}
+
+	chat, err := s.ChatRepository.ChatInfo(ctx, request.ChatId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return JoinRequestDecisionDTO{}, fmt.Errorf("chat %d: %w", request.ChatId, ErrChatNotFound)
+		}
+		logger.Error("failed to get chat info", zap.Int64("chat_id", request.ChatId), zap.Error(err))
+		return JoinRequestDecisionDTO{}, fmt.Errorf("get chat info: %w", err)
+	}
+
+	if _, err := s.checkPermission(ctx, chat.Id, userId, PermAddMembers); err != nil {
+		return JoinRequestDecisionDTO{}, err
+	}
+
+	// Заявку могли одновременно рассмотреть двое, решение принимается только один раз
+	_, err = s.ChatRepository.ResolveJoinRequest(ctx, requestId, userId, approve)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return JoinRequestDecisionDTO{}, fmt.Errorf("join request %d: %w", requestId, ErrJoinRequestNotFound)
+		}
+		logger.Error("failed to resolve join request", zap.Int64("request_id", requestId), zap.Error(err))
+		return JoinRequestDecisionDTO{}, fmt.Errorf("resolve join request: %w", err)
+	}
+
+	logger.Info("join request resolved",
+		zap.Int64("request_id", requestId),
+		zap.Int64("chat_id", chat.Id),
+		zap.Int64("user_id", request.UserId),
+		zap.Int64("decided_by", userId),
+		zap.Bool("approved", approve),
+	)
+
+	if approve {
+		// Инициализируем unread для нового участника
+		err = s.UnreadRepository.InitForMember(ctx, chat.Id, request.UserId)
+		if err != nil {
+			logger.Error("failed to init unread for member", zap.Int64("chat_id", chat.Id), zap.Int64("user_id", request.UserId), zap.Error(err))
+		}
+	}
+
+	return JoinRequestDecisionDTO{
+		RequestId: requestId,
+		ChatId:    chat.Id,
+		ChatName:  chat.Name,
+		UserId:    request.UserId,
+		Approved:  approve,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// LeaveChat выводит пользователя из группового чата. Если выходит владелец, чат переходит к старшему по роли
+// и стажу участнику, а если выходит последний участник, чат удаляется без окна восстановления
+func (s *service) LeaveChat(ctx context.Context, chatId int64, userId int64, username string) error {
+	chat, err := s.ChatRepository.ChatInfo(ctx, chatId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("chat %d: %w", chatId, ErrChatNotFound)
+		}
+		logger.Error("failed to get chat info", zap.Int64("chat_id", chatId), zap.Error(err))
+		return fmt.Errorf("get chat info: %w", err)
+	}
+
+	// Из личного чата не выходят, его удаляют
+	if chat.IsDirect {
+		return fmt.Errorf("chat %d is direct: %w", chatId, ErrDirectChat)
+	}
+
+	result, err := s.ChatRepository.LeaveChat(ctx, chatId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrMemberNotFound)
+		}
+		logger.Error("failed to leave chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return fmt.Errorf("leave chat: %w", err)
+	}
+
+	logger.Info("member left chat",
+		zap.Int64("chat_id", chatId),
+		zap.Int64("user_id", userId),
+		zap.String("role", result.Role),
+		zap.Int64("new_owner_id", result.NewOwnerId),
+		zap.Int("remaining", result.Remaining),
+	)
+
+	err = s.UnreadRepository.DeleteForMember(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to delete unread for member", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+	}
+
+	// Восстановить пустой чат некому: это может только участник. Поэтому сразу отдаем его
+	// на окончательное удаление, файлы сообщений сотрет тот же фоновый процесс
+	if result.Remaining == 0 {
+		err = s.ChatRepository.Delete(ctx, chatId, time.Now())
+		switch {
+		case err == nil:
+			logger.Info("empty chat deleted", zap.Int64("chat_id", chatId))
+		case !errors.Is(err, repository.ErrNotFound):
+			logger.Error("failed to delete empty chat", zap.Int64("chat_id", chatId), zap.Error(err))
+		}
+	}
+
+	s.broadcastMessage(ctx, chatId, MessageDTO{
+		MessageType: MessageTypeMemberLeft,
+		From:        username,
+		CreatedAt:   time.Now(),
+		MemberLeft: &MemberLeftDTO{
+			UserId:     userId,
+			Username:   username,
+			NewOwnerId: result.NewOwnerId,
+		},
+	})
+
+	return nil
+}
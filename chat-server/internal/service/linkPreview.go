@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/linkpreview"
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxConcurrentPreviews сколько превью загружается одновременно, ссылки сверх лимита остаются без превью
+	maxConcurrentPreviews = 16
+	// linkPreviewDeadline время на загрузку, сохранение и рассылку одного превью
+	linkPreviewDeadline = 15 * time.Second
+)
+
+// unfurlLink в фоне загружает превью первой ссылки сообщения, сохраняет его и рассылает подписчикам.
+// Отправка сообщения превью не ждет
+func (s *service) unfurlLink(chatId int64, msg MessageDTO) {
+	rawUrl := linkpreview.FirstURL(msg.Text)
+	if rawUrl == "" {
+		return
+	}
+
+	select {
+	case s.previewSlots <- struct{}{}:
+	default:
+		logger.Debug("link preview skipped, too many in flight", zap.Int64("message_id", msg.Id))
+		return
+	}
+
+	go func() {
+		defer func() { <-s.previewSlots }()
+
+		// Контекст запроса отправки к этому моменту может быть уже отменен
+		ctx, cancel := context.WithTimeout(context.Background(), linkPreviewDeadline)
+		defer cancel()
+
+		preview, err := s.LinkPreviews.Fetch(ctx, rawUrl)
+		if err != nil {
+			logger.Debug("failed to fetch link preview", zap.Int64("message_id", msg.Id), zap.Error(err))
+			return
+		}
+
+		err = s.ChatRepository.SaveLinkPreview(ctx, msg.Id, repository.LinkPreviewDTO{
+			Url:         preview.Url,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageUrl:    preview.ImageUrl,
+			SiteName:    preview.SiteName,
+		})
+		if err != nil {
+			if !errors.Is(err, repository.ErrNotFound) {
+				logger.Warn("failed to save link preview", zap.Int64("message_id", msg.Id), zap.Error(err))
+			}
+			return
+		}
+
+		event := MessageDTO{
+			Id:           msg.Id,
+			MessageType:  MessageTypeLinkPreview,
+			From:         msg.From,
+			CreatedAt:    time.Now(),
+			ThreadRootId: msg.ThreadRootId,
+			LinkPreview: &LinkPreviewDTO{
+				Url:         preview.Url,
+				Title:       preview.Title,
+				Description: preview.Description,
+				ImageUrl:    preview.ImageUrl,
+				SiteName:    preview.SiteName,
+			},
+		}
+
+		s.broadcastMessage(ctx, chatId, event)
+	}()
+}
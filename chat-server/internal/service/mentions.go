@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"go.uber.org/zap"
+)
+
+// maxMentionsPerMessage ограничивает число упоминаний в одном сообщении
+const maxMentionsPerMessage = 50
+
+// mentionPattern @username в начале текста или после символа, который не может быть частью username или email
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@-])@([\p{L}\p{N}_][\p{L}\p{N}_.-]*)`)
+
+// parseMentions возвращает уникальные username из @упоминаний в нижнем регистре
+func parseMentions(text string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	usernames := make([]string, 0, len(matches))
+	for _, match := range matches {
+		// Точка и дефис в конце - это пунктуация, а не часть имени
+		username := strings.ToLower(strings.TrimRight(match[1], ".-"))
+		if username == "" {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+		if len(usernames) == maxMentionsPerMessage {
+			break
+		}
+	}
+
+	return usernames
+}
+
+// saveMentions сохраняет упоминания из текста сообщения и возвращает id упомянутых участников
+func (s *service) saveMentions(ctx context.Context, chatId int64, messageId int64, authorId int64, text string) []int64 {
+	usernames := parseMentions(text)
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	userIds, err := s.ChatRepository.SaveMentions(ctx, chatId, messageId, authorId, usernames)
+	if err != nil {
+		// Сообщение уже сохранено, без упоминаний оно все равно дойдет до участников
+		logger.Warn("failed to save mentions", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+		return nil
+	}
+
+	return userIds
+}
+
+// MentionCounts возвращает непрочитанные упоминания пользователя по чатам
+func (s *service) MentionCounts(ctx context.Context, userId int64) (map[int64]int32, error) {
+	res, err := s.UnreadRepository.AllMentionCounts(ctx, userId)
+	if err != nil {
+		logger.Error("failed to get mention counts", zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("get mention counts: %w", err)
+	}
+	return res, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
@@ -39,26 +40,38 @@ func (s *service) MyChats(ctx context.Context, userId int64) ([]ChatInfoDTO, err
 		lastMessages = make(map[int64]repository.LastMessageDTO)
 	}
 
+	// Получаем последние закрепленные сообщения
+	topPins, err := s.ChatRepository.TopPins(ctx, chatIds)
+	if err != nil {
+		logger.Error("failed to get top pins", zap.Int64s("chat_ids", chatIds), zap.Error(err))
+		topPins = make(map[int64]repository.ReplyPreviewDTO)
+	}
+
 	allChats := make([]ChatInfoDTO, 0, len(chats))
 
 	for _, chat := range chats {
 		usernames := make([]string, 0, len(chat.Members))
 		memberIds := make([]int64, 0, len(chat.Members))
+		memberRoles := make(map[int64]string, len(chat.Members))
 		for _, member := range chat.Members {
 			usernames = append(usernames, member.Username)
 			memberIds = append(memberIds, member.UserId)
+			memberRoles[member.UserId] = member.Role
 		}
 
 		dto := ChatInfoDTO{
-			ID:          chat.Id,
-			Name:        chat.Name,
-			Usernames:   usernames,
-			MemberIds:   memberIds,
-			IsDirect:    chat.IsDirect,
-			IsPublic:    chat.IsPublic,
-			CreatorId:   chat.CreatorId,
-			CreatedAt:   chat.CreatedAt,
-			UnreadCount: unreadCounts[chat.Id],
+			ID:           chat.Id,
+			Name:         chat.Name,
+			Usernames:    usernames,
+			MemberIds:    memberIds,
+			MemberRoles:  memberRoles,
+			IsDirect:     chat.IsDirect,
+			IsPublic:     chat.IsPublic,
+			JoinApproval: chat.JoinApproval,
+			CreatorId:    chat.CreatorId,
+			CreatedAt:    chat.CreatedAt,
+			UnreadCount:  unreadCounts[chat.Id],
+			MessageTTL:   time.Duration(chat.MessageTTLSeconds) * time.Second,
 		}
 
 		if lastMsg, ok := lastMessages[chat.Id]; ok {
@@ -67,6 +80,15 @@ func (s *service) MyChats(ctx context.Context, userId int64) ([]ChatInfoDTO, err
 			dto.LastMessageAt = lastMsg.CreatedAt
 		}
 
+		if pin, ok := topPins[chat.Id]; ok {
+			dto.PinnedMessage = &ReplyPreviewDTO{
+				MessageId:   pin.MessageId,
+				From:        pin.From,
+				Text:        pin.Text,
+				MessageType: messageTypeFromDB(pin.MessageType),
+			}
+		}
+
 		allChats = append(allChats, dto)
 
 	}
@@ -1,28 +1,54 @@
 package service
 
-// OnlineUsers возвращает список онлайн пользователей в чате
-func (s *service) OnlineUsers(chatID int64) []OnlineUserDTO {
-	room := s.getRoom(chatID)
-	if room == nil {
-		return nil
+import (
+	"context"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"go.uber.org/zap"
+)
+
+// OnlineUsers возвращает список онлайн пользователей в чате со всех реплик
+func (s *service) OnlineUsers(ctx context.Context, chatID int64) []OnlineUserDTO {
+	users, err := s.BroadcastBus.OnlineUsers(ctx, chatID)
+	if err != nil {
+		// Если redis недоступен, отдаем хотя бы локальный онлайн
+		logger.Warn("failed to get cluster online users, fallback to local", zap.Int64("chat_id", chatID), zap.Error(err))
+
+		room := s.getRoom(chatID)
+		if room == nil {
+			return nil
+		}
+		return room.GetOnlineUsers()
+	}
+
+	res := make([]OnlineUserDTO, 0, len(users))
+	for _, u := range users {
+		res = append(res, OnlineUserDTO{
+			UserId:   u.UserId,
+			Username: u.Username,
+		})
 	}
-	return room.GetOnlineUsers()
+
+	return res
 }
 
 // GetOnlineCount возвращает количество онлайн пользователей в чате
-func (s *service) OnlineCount(chatID int64) int {
-	room := s.getRoom(chatID)
-	if room == nil {
-		return 0
-	}
-	return room.GetOnlineUsersCount()
+func (s *service) OnlineCount(ctx context.Context, chatID int64) int {
+	return len(s.OnlineUsers(ctx, chatID))
 }
 
 // IsUserOnline проверяет онлайн ли пользователь в чате
-func (s *service) IsUserOnline(chatID int64, userID int64) bool {
+func (s *service) IsUserOnline(ctx context.Context, chatID int64, userID int64) bool {
+	// Сначала смотрим локальную комнату, чтобы не ходить в redis
 	room := s.getRoom(chatID)
-	if room == nil {
-		return false
+	if room != nil && room.IsUserOnline(userID) {
+		return true
+	}
+
+	for _, u := range s.OnlineUsers(ctx, chatID) {
+		if u.UserId == userID {
+			return true
+		}
 	}
-	return room.IsUserOnline(userID)
+	return false
 }
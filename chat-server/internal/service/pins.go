@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// PinMessage закрепляет сообщение ленты чата и рассылает событие подписчикам
+func (s *service) PinMessage(ctx context.Context, userId int64, username string, chatId int64, messageId int64) error {
+	msg, err := s.pinTarget(ctx, userId, chatId, messageId)
+	if err != nil {
+		return err
+	}
+
+	if msg.DeletedAt != nil {
+		return fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+	}
+
+	pinned, err := s.ChatRepository.PinMessage(ctx, chatId, messageId, userId, username)
+	if err != nil {
+		logger.Error("failed to pin message", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("pin message: %w", err)
+	}
+
+	// Уже было закреплено, подписчикам ничего не шлем
+	if !pinned {
+		return nil
+	}
+
+	s.broadcastPin(ctx, chatId, userId, username, msg, true)
+
+	return nil
+}
+
+// UnpinMessage открепляет сообщение и рассылает событие подписчикам
+func (s *service) UnpinMessage(ctx context.Context, userId int64, username string, chatId int64, messageId int64) error {
+	msg, err := s.pinTarget(ctx, userId, chatId, messageId)
+	if err != nil {
+		return err
+	}
+
+	unpinned, err := s.ChatRepository.UnpinMessage(ctx, chatId, messageId)
+	if err != nil {
+		logger.Error("failed to unpin message", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("unpin message: %w", err)
+	}
+
+	if !unpinned {
+		return nil
+	}
+
+	s.broadcastPin(ctx, chatId, userId, username, msg, false)
+
+	return nil
+}
+
+// ListPinned возвращает закрепленные сообщения чата, последние закрепленные первыми
+func (s *service) ListPinned(ctx context.Context, userId int64, chatId int64) ([]PinnedMessageDTO, error) {
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, chatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		return nil, fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+	}
+
+	pins, err := s.ChatRepository.PinnedMessages(ctx, chatId)
+	if err != nil {
+		logger.Error("failed to get pinned messages", zap.Int64("chat_id", chatId), zap.Error(err))
+		return nil, fmt.Errorf("get pinned messages: %w", err)
+	}
+
+	messages := make([]MessageDTO, 0, len(pins))
+	for _, pin := range pins {
+		messages = append(messages, toMessageDTO(pin.Message))
+	}
+
+	s.attachReactions(ctx, userId, messages)
+	s.attachPolls(ctx, userId, messages)
+
+	res := make([]PinnedMessageDTO, 0, len(pins))
+	for i, pin := range pins {
+		res = append(res, PinnedMessageDTO{
+			Message:          messages[i],
+			PinnedBy:         pin.PinnedBy,
+			PinnedByUsername: pin.PinnedByUsername,
+			PinnedAt:         pin.PinnedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// pinTarget проверяет право закреплять в чате и что сообщение из ленты этого чата
+func (s *service) pinTarget(ctx context.Context, userId int64, chatId int64, messageId int64) (*repository.MessageDTO, error) {
+	if _, err := s.checkPermission(ctx, chatId, userId, PermPinMessages); err != nil {
+		return nil, err
+	}
+
+	msg, err := s.ChatRepository.MessageById(ctx, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+		}
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+
+	// Закрепляются только сообщения ленты этого чата
+	if msg.ChatId != chatId || msg.ThreadRootId != nil {
+		return nil, fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+	}
+
+	return msg, nil
+}
+
+// broadcastPin рассылает подписчикам чата системное событие о закреплении или откреплении
+func (s *service) broadcastPin(ctx context.Context, chatId int64, userId int64, username string, msg *repository.MessageDTO, pinned bool) {
+	s.broadcastMessage(ctx, chatId, MessageDTO{
+		Id:          msg.Id,
+		MessageType: MessageTypePin,
+		From:        username,
+		CreatedAt:   time.Now(),
+		Pin: &PinEventDTO{
+			UserId:   userId,
+			Username: username,
+			Pinned:   pinned,
+			Message: &ReplyPreviewDTO{
+				MessageId:   msg.Id,
+				From:        msg.From,
+				Text:        previewText(msg.Text),
+				MessageType: messageTypeFromDB(msg.MessageType),
+				Deleted:     msg.DeletedAt != nil,
+			},
+		},
+	})
+}
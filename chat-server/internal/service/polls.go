@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// VotePoll заменяет голос пользователя в опросе на выбранные варианты, пустой список отзывает голос.
+// Возвращает опрос с учетом нового голоса, остальным участникам рассылаются обновленные счетчики
+func (s *service) VotePoll(ctx context.Context, userId int64, messageId int64, optionIds []int64) (PollDTO, error) {
+	msg, err := s.pollMessage(ctx, userId, messageId)
+	if err != nil {
+		return PollDTO{}, err
+	}
+	if _, err := s.checkPermission(ctx, msg.ChatId, userId, PermReact); err != nil {
+		return PollDTO{}, err
+	}
+
+	poll, err := s.loadPoll(ctx, messageId, userId)
+	if err != nil {
+		return PollDTO{}, fmt.Errorf("load poll: %w", err)
+	}
+	if poll == nil {
+		return PollDTO{}, fmt.Errorf("poll %d: %w", messageId, ErrMessageNotFound)
+	}
+	if poll.Closed {
+		return PollDTO{}, fmt.Errorf("poll %d: %w", messageId, ErrPollClosed)
+	}
+
+	if len(optionIds) > 1 && !poll.MultipleChoice {
+		return PollDTO{}, fmt.Errorf("poll %d allows a single option: %w", messageId, ErrInvalidPollVote)
+	}
+
+	// Варианты должны быть из этого опроса
+	valid := make(map[int64]struct{}, len(poll.Options))
+	for _, option := range poll.Options {
+		valid[option.Id] = struct{}{}
+	}
+	for _, id := range optionIds {
+		if _, ok := valid[id]; !ok {
+			return PollDTO{}, fmt.Errorf("option %d is not in poll %d: %w", id, messageId, ErrInvalidPollVote)
+		}
+	}
+
+	open, err := s.ChatRepository.VotePoll(ctx, messageId, userId, optionIds)
+	if err != nil {
+		logger.Error("failed to vote poll", zap.Int64("message_id", messageId), zap.Int64("user_id", userId), zap.Error(err))
+		return PollDTO{}, fmt.Errorf("vote poll: %w", err)
+	}
+	// Опрос могли закрыть между проверкой и голосованием
+	if !open {
+		return PollDTO{}, fmt.Errorf("poll %d: %w", messageId, ErrPollClosed)
+	}
+
+	logger.Info("poll voted", zap.Int64("message_id", messageId), zap.Int64("user_id", userId), zap.Int("options", len(optionIds)))
+
+	s.broadcastPollUpdated(ctx, msg)
+
+	// Голос уже учтен, ошибка здесь значит только что не удалось отдать свежие счетчики
+	poll, err = s.loadPoll(ctx, messageId, userId)
+	if err != nil {
+		return PollDTO{}, fmt.Errorf("reload poll: %w", err)
+	}
+	if poll == nil {
+		return PollDTO{}, fmt.Errorf("poll %d: %w", messageId, ErrMessageNotFound)
+	}
+
+	return *poll, nil
+}
+
+// ClosePoll досрочно закрывает опрос. Закрыть может автор опроса или модераторы чата
+func (s *service) ClosePoll(ctx context.Context, userId int64, messageId int64) error {
+	msg, err := s.pollMessage(ctx, userId, messageId)
+	if err != nil {
+		return err
+	}
+
+	// Чужой опрос закрывают те, кому можно удалять чужие сообщения
+	if msg.UserId != userId {
+		if _, err := s.checkPermission(ctx, msg.ChatId, userId, PermDeleteMessages); err != nil {
+			return err
+		}
+	}
+
+	closed, err := s.ChatRepository.ClosePoll(ctx, messageId)
+	if err != nil {
+		logger.Error("failed to close poll", zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("close poll: %w", err)
+	}
+	if !closed {
+		return fmt.Errorf("poll %d: %w", messageId, ErrPollClosed)
+	}
+
+	logger.Info("poll closed", zap.Int64("chat_id", msg.ChatId), zap.Int64("message_id", messageId), zap.Int64("user_id", userId))
+
+	s.broadcastPollUpdated(ctx, msg)
+
+	return nil
+}
+
+// pollMessage возвращает сообщение-опрос, если пользователь может его видеть
+func (s *service) pollMessage(ctx context.Context, userId int64, messageId int64) (*repository.MessageDTO, error) {
+	msg, err := s.ChatRepository.MessageById(ctx, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("message %d: %w", messageId, ErrMessageNotFound)
+		}
+		logger.Error("failed to get message", zap.Int64("message_id", messageId), zap.Error(err))
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+
+	if msg.DeletedAt != nil || msg.MessageType != "poll" {
+		return nil, fmt.Errorf("poll %d: %w", messageId, ErrMessageNotFound)
+	}
+
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, msg.ChatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", userId))
+		return nil, fmt.Errorf("poll %d: %w", messageId, ErrMessageNotFound)
+	}
+
+	return msg, nil
+}
+
+// broadcastPollUpdated рассылает подписчикам актуальные счетчики опроса
+func (s *service) broadcastPollUpdated(ctx context.Context, msg *repository.MessageDTO) {
+	// Без пользователя VotedByMe везде false
+	poll, err := s.loadPoll(ctx, msg.Id, 0)
+	if err != nil || poll == nil {
+		logger.Warn("failed to load poll for broadcast", zap.Int64("message_id", msg.Id), zap.Error(err))
+		return
+	}
+
+	event := MessageDTO{
+		Id:          msg.Id,
+		MessageType: MessageTypePollUpdated,
+		From:        msg.From,
+		CreatedAt:   time.Now(),
+		Poll:        poll,
+	}
+	if msg.ThreadRootId != nil {
+		event.ThreadRootId = *msg.ThreadRootId
+	}
+
+	s.broadcastMessage(ctx, msg.ChatId, event)
+}
+
+// loadPoll возвращает опрос сообщения с точки зрения пользователя, nil если у сообщения нет опроса
+func (s *service) loadPoll(ctx context.Context, messageId int64, userId int64) (*PollDTO, error) {
+	polls, err := s.ChatRepository.Polls(ctx, []int64{messageId}, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	poll, ok := polls[messageId]
+	if !ok {
+		return nil, nil
+	}
+
+	res := toPollDTO(poll)
+	return &res, nil
+}
+
+// attachPolls добавляет к опросам варианты и голоса с точки зрения пользователя.
+// Без голосов история все равно отдается, поэтому ошибку только логируем
+func (s *service) attachPolls(ctx context.Context, userId int64, messages []MessageDTO) {
+	var ids []int64
+	for _, msg := range messages {
+		if msg.MessageType == MessageTypePoll && !msg.Deleted {
+			ids = append(ids, msg.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	polls, err := s.ChatRepository.Polls(ctx, ids, userId)
+	if err != nil {
+		logger.Warn("failed to load polls", zap.Int64("user_id", userId), zap.Error(err))
+		return
+	}
+
+	for i := range messages {
+		if poll, ok := polls[messages[i].Id]; ok {
+			res := toPollDTO(poll)
+			messages[i].Poll = &res
+		}
+	}
+}
+
+// toPollDTO конвертирует опрос из БД в опрос для клиента
+func toPollDTO(poll repository.PollDTO) PollDTO {
+	res := PollDTO{
+		MultipleChoice: poll.MultipleChoice,
+		Anonymous:      poll.Anonymous,
+		Closed:         poll.Closed,
+		TotalVoters:    poll.TotalVoters,
+		Options:        make([]PollOptionDTO, 0, len(poll.Options)),
+	}
+	if poll.ClosesAt != nil {
+		res.ClosesAt = *poll.ClosesAt
+	}
+	for _, option := range poll.Options {
+		res.Options = append(res.Options, PollOptionDTO{
+			Id:        option.Id,
+			Text:      option.Text,
+			Votes:     option.Votes,
+			VotedByMe: option.VotedByMe,
+			VoterIds:  option.VoterIds,
+		})
+	}
+
+	return res
+}
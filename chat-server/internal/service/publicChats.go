@@ -18,11 +18,12 @@ func (s *service) PublicChats(ctx context.Context, search string) ([]PublicChatD
 	res := make([]PublicChatDTO, 0, len(chats))
 	for _, chat := range chats {
 		res = append(res, PublicChatDTO{
-			Id:          chat.Id,
-			Name:        chat.Name,
-			MemberCount: chat.MemberCount,
-			CreatorName: chat.CreatorName,
-			CreatedAt:   chat.CreatedAt,
+			Id:           chat.Id,
+			Name:         chat.Name,
+			MemberCount:  chat.MemberCount,
+			CreatorName:  chat.CreatorName,
+			CreatedAt:    chat.CreatedAt,
+			JoinApproval: chat.JoinApproval,
 		})
 	}
 
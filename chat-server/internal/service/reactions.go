@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// AddReaction ставит реакцию на сообщение
+func (s *service) AddReaction(ctx context.Context, userId int64, username string, messageId int64, emoji string) error {
+	return s.changeReaction(ctx, userId, username, messageId, emoji, true)
+}
+
+// RemoveReaction снимает реакцию с сообщения
+func (s *service) RemoveReaction(ctx context.Context, userId int64, username string, messageId int64, emoji string) error {
+	return s.changeReaction(ctx, userId, username, messageId, emoji, false)
+}
+
+func (s *service) changeReaction(ctx context.Context, userId int64, username string, messageId int64, emoji string, add bool) error {
+	msg, err := s.ChatRepository.MessageById(ctx, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrMessageNotFound
+		}
+		logger.Error("failed to get message", zap.Int64("message_id", messageId), zap.Error(err))
+		return fmt.Errorf("get message: %w", err)
+	}
+
+	// На удаленные сообщения реакции не ставятся
+	if msg.DeletedAt != nil {
+		return ErrMessageNotFound
+	}
+
+	// Для не участника чата сообщение не существует
+	role, err := s.ChatRepository.MemberRole(ctx, msg.ChatId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			logger.Warn("user not in chat", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", userId))
+			return ErrMessageNotFound
+		}
+		logger.Error("failed to get member role", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", userId), zap.Error(err))
+		return fmt.Errorf("get member role: %w", err)
+	}
+	if !Can(role, PermReact) {
+		return fmt.Errorf("role %q cannot react: %w", role, ErrPermissionDenied)
+	}
+
+	var changed bool
+	if add {
+		changed, err = s.ChatRepository.AddReaction(ctx, messageId, userId, emoji)
+	} else {
+		changed, err = s.ChatRepository.RemoveReaction(ctx, messageId, userId, emoji)
+	}
+	if err != nil {
+		logger.Error("failed to change reaction", zap.Int64("message_id", messageId), zap.Int64("user_id", userId), zap.Bool("add", add), zap.Error(err))
+		return fmt.Errorf("change reaction: %w", err)
+	}
+
+	// Повторная постановка или снятие ничего не меняют, рассылать нечего
+	if !changed {
+		return nil
+	}
+
+	count, err := s.ChatRepository.ReactionCount(ctx, messageId, emoji)
+	if err != nil {
+		logger.Warn("failed to count reactions", zap.Int64("message_id", messageId), zap.Error(err))
+	}
+
+	event := MessageDTO{
+		Id:          messageId,
+		MessageType: MessageTypeReaction,
+		From:        username,
+		CreatedAt:   time.Now(),
+		Reaction: &ReactionEventDTO{
+			Emoji:    emoji,
+			UserId:   userId,
+			Username: username,
+			Added:    add,
+			Count:    count,
+		},
+	}
+	if msg.ThreadRootId != nil {
+		event.ThreadRootId = *msg.ThreadRootId
+	}
+
+	s.broadcastMessage(ctx, msg.ChatId, event)
+
+	return nil
+}
+
+// attachReactions добавляет к сообщениям сводку реакций с точки зрения пользователя.
+// Без реакций история все равно отдается, поэтому ошибку только логируем
+func (s *service) attachReactions(ctx context.Context, userId int64, messages []MessageDTO) {
+	if len(messages) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.Id)
+	}
+
+	summaries, err := s.ChatRepository.ReactionSummaries(ctx, ids, userId)
+	if err != nil {
+		logger.Warn("failed to load reactions", zap.Int64("user_id", userId), zap.Error(err))
+		return
+	}
+
+	for i := range messages {
+		for _, r := range summaries[messages[i].Id] {
+			messages[i].Reactions = append(messages[i].Reactions, ReactionDTO{
+				Emoji:       r.Emoji,
+				Count:       r.Count,
+				ReactedByMe: r.ReactedByMe,
+			})
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
 	"go.uber.org/zap"
 )
 
@@ -22,43 +23,47 @@ func (s *service) RemoveMember(ctx context.Context, chatId int64, userId int64,
 		return errors.New("cannot remove members from direct chat")
 	}
 
-	if chat.CreatorId != userId {
-		logger.Warn("only owner can remove members from chat", zap.Int64("chat_id", chatId))
-		return errors.New("only owner can remove members from chat")
+	actorRole, err := s.checkPermission(ctx, chatId, userId, PermRemoveMembers)
+	if err != nil {
+		return err
 	}
 
-	// Удаление по username
-	if targetUsername != "" {
-		// Проверяем, что не пытаемся удалить себя
-		for _, m := range chat.Members {
-			if m.Username == targetUsername && m.UserId == userId {
-				return errors.New("cannot remove yourself from chat")
-			}
-		}
-
-		err = s.ChatRepository.RemoveMemberByUsername(ctx, chatId, targetUsername)
-		if err != nil {
-			logger.Error("failed to remove member by username", zap.Int64("chat_id", chatId), zap.String("username", targetUsername), zap.Error(err))
-			return fmt.Errorf("remove member by username: %w", err)
+	// Исключаемого ищем по username или по id
+	var target *repository.MemberDTO
+	for i, m := range chat.Members {
+		if (targetUsername != "" && m.Username == targetUsername) || (targetUsername == "" && m.UserId == targetUserId) {
+			target = &chat.Members[i]
+			break
 		}
-
-		logger.Info("member removed from chat by username", zap.Int64("chat_id", chatId), zap.String("username", targetUsername))
-		return nil
+	}
+	if target == nil {
+		return fmt.Errorf("user %d %q: %w", targetUserId, targetUsername, ErrMemberNotFound)
 	}
 
-	// Нельзя удалить самого себя
-	if userId == targetUserId {
+	// Нельзя удалить самого себя, для этого есть LeaveChat
+	if target.UserId == userId {
 		logger.Warn("cannot remove yourself from chat", zap.Int64("chat_id", chatId))
-		return errors.New("cannot remove yourself from chat")
+		return errors.New("cannot remove yourself from chat, use LeaveChat")
 	}
 
-	err = s.ChatRepository.RemoveMember(ctx, chatId, targetUserId)
+	// Исключать можно только тех, чья роль младше
+	if !Outranks(actorRole, target.Role) {
+		logger.Warn("cannot remove member with same or higher role", zap.Int64("chat_id", chatId), zap.String("actor_role", actorRole), zap.String("target_role", target.Role))
+		return fmt.Errorf("role %q cannot remove %q: %w", actorRole, target.Role, ErrPermissionDenied)
+	}
+
+	err = s.ChatRepository.RemoveMember(ctx, chatId, target.UserId)
 	if err != nil {
-		logger.Error("failed to remove member", zap.Int64("chat_id", chatId), zap.Int64("user_id", targetUserId), zap.Error(err))
+		logger.Error("failed to remove member", zap.Int64("chat_id", chatId), zap.Int64("user_id", target.UserId), zap.Error(err))
 		return fmt.Errorf("remove member: %w", err)
 	}
 
-	logger.Info("member removed from chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", targetUserId))
+	logger.Info("member removed from chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", target.UserId))
+
+	err = s.UnreadRepository.DeleteForMember(ctx, chatId, target.UserId)
+	if err != nil {
+		logger.Error("failed to delete unread for member", zap.Int64("chat_id", chatId), zap.Int64("user_id", target.UserId), zap.Error(err))
+	}
 
 	return nil
 }
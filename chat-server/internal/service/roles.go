@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// Роли участников чата, хранятся в chat_members.role
+const (
+	RoleOwner     = "owner"
+	RoleAdmin     = "admin"
+	RoleModerator = "moderator"
+	RoleMember    = "member"
+	RoleReadOnly  = "read_only"
+)
+
+// Permission действие в чате, которое разрешается ролью
+type Permission int
+
+const (
+	PermSendMessages   Permission = iota // Отправка, пересылка и редактирование своих сообщений, "печатает"
+	PermReact                            // Реакции и голосование в опросах
+	PermAddMembers                       // Добавление участников в закрытый чат, приглашения и заявки на вступление
+	PermRemoveMembers                    // Исключение участников с ролью ниже своей
+	PermPinMessages                      // Закрепление и открепление сообщений
+	PermDeleteMessages                   // Удаление чужих сообщений для всех и закрытие чужих опросов
+	PermEditChatInfo                     // Настройки чата, например время жизни сообщений
+	PermManageRoles                      // Назначение ролей ниже своей
+)
+
+// rolePermissions матрица прав: что разрешено каждой роли.
+// Передать чат может только владелец, это не отдельное право
+var rolePermissions = map[string]map[Permission]bool{
+	RoleOwner: {
+		PermSendMessages: true, PermReact: true, PermAddMembers: true, PermRemoveMembers: true,
+		PermPinMessages: true, PermDeleteMessages: true, PermEditChatInfo: true, PermManageRoles: true,
+	},
+	RoleAdmin: {
+		PermSendMessages: true, PermReact: true, PermAddMembers: true, PermRemoveMembers: true,
+		PermPinMessages: true, PermDeleteMessages: true, PermEditChatInfo: true, PermManageRoles: true,
+	},
+	RoleModerator: {
+		PermSendMessages: true, PermReact: true, PermAddMembers: true, PermRemoveMembers: true,
+		PermPinMessages: true, PermDeleteMessages: true,
+	},
+	RoleMember: {
+		PermSendMessages: true, PermReact: true,
+	},
+	RoleReadOnly: {
+		PermReact: true,
+	},
+}
+
+// roleRanks старшинство ролей: исключать участников и менять им роль можно только тем, кто младше
+var roleRanks = map[string]int{
+	RoleOwner:     5,
+	RoleAdmin:     4,
+	RoleModerator: 3,
+	RoleMember:    2,
+	RoleReadOnly:  1,
+}
+
+// Can проверяет, разрешено ли роли действие
+func Can(role string, perm Permission) bool {
+	return rolePermissions[role][perm]
+}
+
+// Outranks проверяет, что роль role старше роли other
+func Outranks(role string, other string) bool {
+	return roleRanks[role] > roleRanks[other]
+}
+
+// IsValidRole проверяет, что роль существует
+func IsValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// checkPermission возвращает роль пользователя в чате, если ей разрешено действие perm.
+// Не участнику чата и роли без права возвращается ErrPermissionDenied
+func (s *service) checkPermission(ctx context.Context, chatId int64, userId int64, perm Permission) (string, error) {
+	role, err := s.ChatRepository.MemberRole(ctx, chatId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			logger.Warn("user not in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId))
+			return "", fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+		}
+		logger.Error("failed to get member role", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Error(err))
+		return "", fmt.Errorf("get member role: %w", err)
+	}
+
+	if !Can(role, perm) {
+		logger.Warn("action is not allowed for role", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.String("role", role), zap.Int("permission", int(perm)))
+		return role, fmt.Errorf("role %q: %w", role, ErrPermissionDenied)
+	}
+
+	return role, nil
+}
+
+// SetMemberRole назначает участнику роль. Назначать можно только роли младше своей и только тем, кто младше.
+// Владельцем становятся только через TransferOwnership
+func (s *service) SetMemberRole(ctx context.Context, userId int64, chatId int64, targetUserId int64, role string) error {
+	if !IsValidRole(role) || role == RoleOwner {
+		return fmt.Errorf("role %q: %w", role, ErrInvalidRole)
+	}
+	if userId == targetUserId {
+		return fmt.Errorf("cannot change own role: %w", ErrPermissionDenied)
+	}
+
+	chat, err := s.ChatRepository.ChatInfo(ctx, chatId)
+	if err != nil {
+		logger.Error("failed to get chat info", zap.Int64("chat_id", chatId), zap.Error(err))
+		return fmt.Errorf("get chat info: %w", err)
+	}
+	if chat.IsDirect {
+		return fmt.Errorf("chat %d is direct: %w", chatId, ErrDirectChat)
+	}
+
+	actorRole, err := s.checkPermission(ctx, chatId, userId, PermManageRoles)
+	if err != nil {
+		return err
+	}
+
+	targetRole, err := s.ChatRepository.MemberRole(ctx, chatId, targetUserId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("user %d: %w", targetUserId, ErrMemberNotFound)
+		}
+		return fmt.Errorf("get member role: %w", err)
+	}
+
+	if !Outranks(actorRole, targetRole) || !Outranks(actorRole, role) {
+		logger.Warn("role change is not allowed", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.String("actor_role", actorRole), zap.String("target_role", targetRole), zap.String("role", role))
+		return fmt.Errorf("role %q cannot change %q to %q: %w", actorRole, targetRole, role, ErrPermissionDenied)
+	}
+
+	if targetRole == role {
+		return nil
+	}
+
+	err = s.ChatRepository.SetMemberRole(ctx, chatId, targetUserId, targetRole, role)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			// Участник вышел или роль поменяли параллельно
+			return fmt.Errorf("user %d: %w", targetUserId, ErrMemberNotFound)
+		}
+		logger.Error("failed to set member role", zap.Int64("chat_id", chatId), zap.Int64("user_id", targetUserId), zap.Error(err))
+		return fmt.Errorf("set member role: %w", err)
+	}
+
+	logger.Info("member role changed", zap.Int64("chat_id", chatId), zap.Int64("user_id", targetUserId), zap.String("from", targetRole), zap.String("to", role), zap.Int64("changed_by", userId))
+
+	return nil
+}
+
+// TransferOwnership передает чат другому участнику, прежний владелец становится админом
+func (s *service) TransferOwnership(ctx context.Context, userId int64, chatId int64, newOwnerId int64) error {
+	if userId == newOwnerId {
+		return fmt.Errorf("user %d already owns chat: %w", userId, ErrPermissionDenied)
+	}
+
+	role, err := s.ChatRepository.MemberRole(ctx, chatId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+		}
+		return fmt.Errorf("get member role: %w", err)
+	}
+	if role != RoleOwner {
+		logger.Warn("only owner can transfer chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.String("role", role))
+		return fmt.Errorf("role %q cannot transfer ownership: %w", role, ErrPermissionDenied)
+	}
+
+	err = s.ChatRepository.TransferOwnership(ctx, chatId, userId, newOwnerId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("user %d: %w", newOwnerId, ErrMemberNotFound)
+		}
+		logger.Error("failed to transfer ownership", zap.Int64("chat_id", chatId), zap.Int64("new_owner_id", newOwnerId), zap.Error(err))
+		return fmt.Errorf("transfer ownership: %w", err)
+	}
+
+	logger.Info("chat ownership transferred", zap.Int64("chat_id", chatId), zap.Int64("from", userId), zap.Int64("to", newOwnerId))
+
+	return nil
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"sync"
-	"time"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
 	"go.uber.org/zap"
@@ -54,6 +53,20 @@ func (r *ChatRoom) RemoveSubscriber(userId int64) (chan MessageDTO, bool) {
 	return sub.Channel, len(r.subscribers) == 0
 }
 
+// RemoveAll удаляет из комнаты всех подписчиков и возвращает их, каналы закрывает вызывающий
+func (r *ChatRoom) RemoveAll() []*Subscriber {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subs := make([]*Subscriber, 0, len(r.subscribers))
+	for userId, sub := range r.subscribers {
+		subs = append(subs, sub)
+		delete(r.subscribers, userId)
+	}
+
+	return subs
+}
+
 // GetOnlineUsers возвращает список онлайн пользователей, их Id и username
 func (r *ChatRoom) GetOnlineUsers() []OnlineUserDTO {
 	r.mu.RLock()
@@ -91,51 +104,21 @@ func (r *ChatRoom) IsEmpty() bool {
 	return len(r.subscribers) == 0
 }
 
-// BroadcastMessage рассылает сообщение всем подписчикам в комнате
+// BroadcastMessage рассылает сообщение всем подписчикам в комнате, либо только адресату если задан ToUserId
 func (r *ChatRoom) BroadcastMessage(msg MessageDTO) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	for _, sub := range r.subscribers {
-		select {
-		case sub.Channel <- msg:
-		default:
-			// Канал переполнен, пропускаем
-			logger.Warn("failed to deliver message - channel full, skip message", zap.Int64("user_id", sub.UserId))
+		if msg.ToUserId != 0 && sub.UserId != msg.ToUserId {
+			continue
 		}
-	}
-}
 
-func (r *ChatRoom) BroadcastOnlineUsers() {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	if len(r.subscribers) == 0 {
-		return
-	}
-
-	// Собираем список онлайн пользователей
-	onlineUsers := make([]OnlineUserDTO, 0, len(r.subscribers))
-	for _, sub := range r.subscribers {
-		onlineUsers = append(onlineUsers, OnlineUserDTO{
-			UserId:   sub.UserId,
-			Username: sub.Username,
-		})
-	}
-
-	// Упаковываем в MessageDTO с специальным типом
-	msg := MessageDTO{
-		MessageType: MessageTypeOnlineUsers,
-		OnlineUsers: onlineUsers,
-		CreatedAt:   time.Now(),
-	}
-
-	for _, sub := range r.subscribers {
 		select {
 		case sub.Channel <- msg:
 		default:
-			logger.Warn("failed to deliver message - channel full, skip online update", zap.Int64("user_id", sub.UserId))
+			// Канал переполнен, пропускаем
+			logger.Warn("failed to deliver message - channel full, skip message", zap.Int64("user_id", sub.UserId))
 		}
 	}
-
 }
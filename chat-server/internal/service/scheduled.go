@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository/scheduled"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	scheduledBatchSize   = 100
+	scheduledLease       = time.Minute // Сколько реплика держит сообщение на время отправки
+	scheduledMaxAttempts = 5
+	scheduledRetryDelay  = 30 * time.Second
+)
+
+// ScheduleMessage сохраняет сообщение для отправки в sendAt
+func (s *service) ScheduleMessage(ctx context.Context, msg SendMessageDTO, sendAt time.Time) (ScheduledMessageDTO, error) {
+	if _, err := s.checkPermission(ctx, msg.ChatId, msg.UserId, PermSendMessages); err != nil {
+		return ScheduledMessageDTO{}, err
+	}
+
+	input := scheduled.ScheduledMessageCreateDTO{
+		ChatId:        msg.ChatId,
+		UserId:        msg.UserId,
+		FromUsername:  msg.FromUsername,
+		Text:          msg.Text,
+		MessageType:   messageTypeToDB(msg.MessageType),
+		VoiceDuration: msg.VoiceDuration,
+		FileUrl:       msg.FileUrl,
+		FileName:      msg.FileName,
+		FileSize:      msg.FileSize,
+		SendAt:        sendAt,
+	}
+	if msg.ReplyToId > 0 {
+		input.ReplyToId = &msg.ReplyToId
+	}
+	if msg.ThreadRootId > 0 {
+		input.ThreadRootId = &msg.ThreadRootId
+	}
+
+	created, err := s.ScheduledRepository.Create(ctx, input)
+	if err != nil {
+		logger.Error("failed to schedule message", zap.Int64("chat_id", msg.ChatId), zap.Int64("user_id", msg.UserId), zap.Error(err))
+		return ScheduledMessageDTO{}, fmt.Errorf("schedule message: %w", err)
+	}
+
+	logger.Info("message scheduled", zap.Int64("chat_id", msg.ChatId), zap.Int64("scheduled_id", created.Id), zap.Time("send_at", created.SendAt))
+
+	return toScheduledMessageDTO(created), nil
+}
+
+// ListScheduled возвращает отложенные сообщения пользователя, chatId 0 - по всем чатам
+func (s *service) ListScheduled(ctx context.Context, userId int64, chatId int64) ([]ScheduledMessageDTO, error) {
+	messages, err := s.ScheduledRepository.List(ctx, userId, chatId)
+	if err != nil {
+		logger.Error("failed to list scheduled messages", zap.Int64("user_id", userId), zap.Int64("chat_id", chatId), zap.Error(err))
+		return nil, fmt.Errorf("list scheduled messages: %w", err)
+	}
+
+	res := make([]ScheduledMessageDTO, 0, len(messages))
+	for _, msg := range messages {
+		res = append(res, toScheduledMessageDTO(msg))
+	}
+
+	return res, nil
+}
+
+// CancelScheduled отменяет отложенное сообщение автора
+func (s *service) CancelScheduled(ctx context.Context, userId int64, id int64) error {
+	err := s.ScheduledRepository.Cancel(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, scheduled.ErrNotFound) {
+			return fmt.Errorf("scheduled message %d: %w", id, ErrMessageNotFound)
+		}
+		logger.Error("failed to cancel scheduled message", zap.Int64("scheduled_id", id), zap.Error(err))
+		return fmt.Errorf("cancel scheduled message: %w", err)
+	}
+
+	logger.Info("scheduled message canceled", zap.Int64("scheduled_id", id), zap.Int64("user_id", userId))
+
+	return nil
+}
+
+// RunScheduledDispatcher периодически отправляет отложенные сообщения, время которых наступило.
+// Безопасно запускать на нескольких репликах: каждое сообщение забирает только одна из них
+func (s *service) RunScheduledDispatcher(ctx context.Context) error {
+	logger.Info("scheduled dispatcher started", zap.Duration("interval", s.scheduledInterval))
+
+	ticker := time.NewTicker(s.scheduledInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			s.dispatchScheduled(ctx)
+		}
+	}
+}
+
+// dispatchScheduled отправляет все наступившие сообщения пачками
+func (s *service) dispatchScheduled(ctx context.Context) {
+	for ctx.Err() == nil {
+		due, err := s.ScheduledRepository.ClaimDue(ctx, scheduledBatchSize, scheduledLease)
+		if err != nil {
+			logger.Error("failed to claim scheduled messages", zap.Error(err))
+			return
+		}
+
+		for _, msg := range due {
+			s.dispatchOne(ctx, msg)
+		}
+
+		if len(due) < scheduledBatchSize {
+			return
+		}
+	}
+}
+
+// dispatchOne отправляет отложенное сообщение обычным путем и убирает его из очереди
+func (s *service) dispatchOne(ctx context.Context, msg scheduled.ScheduledMessageDTO) {
+	send := SendMessageDTO{
+		ChatId:        msg.ChatId,
+		UserId:        msg.UserId,
+		FromUsername:  msg.FromUsername,
+		Text:          msg.Text,
+		MessageType:   messageTypeFromDB(msg.MessageType),
+		VoiceDuration: msg.VoiceDuration,
+		FileUrl:       msg.FileUrl,
+		FileName:      msg.FileName,
+		FileSize:      msg.FileSize,
+	}
+	if msg.ReplyToId != nil {
+		send.ReplyToId = *msg.ReplyToId
+	}
+	if msg.ThreadRootId != nil {
+		send.ThreadRootId = *msg.ThreadRootId
+	}
+	// Ключ выводим из id отложенного: если Complete не прошел, повторная отправка после аренды не создаст дубль
+	send.ClientKey = uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("scheduled_message:%d", msg.Id))).String()
+
+	sent, err := s.SendMessage(ctx, send)
+	if err != nil {
+		// Цитата или корень треда удалены, автора исключили из чата или лишили права писать - повтор не поможет
+		s.failScheduled(ctx, msg, err, errors.Is(err, ErrMessageNotFound) || errors.Is(err, ErrPermissionDenied))
+		return
+	}
+
+	if err := s.ScheduledRepository.Complete(ctx, msg.Id); err != nil {
+		logger.Error("failed to complete scheduled message", zap.Int64("scheduled_id", msg.Id), zap.Int64("message_id", sent.Id), zap.Error(err))
+		return
+	}
+
+	logger.Info("scheduled message sent", zap.Int64("scheduled_id", msg.Id), zap.Int64("chat_id", msg.ChatId), zap.Int64("message_id", sent.Id))
+}
+
+// failScheduled снимает аренду и откладывает повтор, после scheduledMaxAttempts попыток сообщение помечается неотправленным
+func (s *service) failScheduled(ctx context.Context, msg scheduled.ScheduledMessageDTO, reason error, permanent bool) {
+	if msg.Attempts >= scheduledMaxAttempts {
+		permanent = true
+	}
+
+	logger.Warn("failed to send scheduled message", zap.Int64("scheduled_id", msg.Id), zap.Int32("attempts", msg.Attempts), zap.Bool("permanent", permanent), zap.Error(reason))
+
+	err := s.ScheduledRepository.Fail(ctx, msg.Id, reason.Error(), time.Duration(msg.Attempts)*scheduledRetryDelay, permanent)
+	if err != nil {
+		logger.Error("failed to mark scheduled message as failed", zap.Int64("scheduled_id", msg.Id), zap.Error(err))
+	}
+}
+
+// toScheduledMessageDTO конвертирует отложенное сообщение из БД в сообщение для клиента
+func toScheduledMessageDTO(msg scheduled.ScheduledMessageDTO) ScheduledMessageDTO {
+	res := ScheduledMessageDTO{
+		Id:            msg.Id,
+		ChatId:        msg.ChatId,
+		MessageType:   messageTypeFromDB(msg.MessageType),
+		Text:          msg.Text,
+		VoiceDuration: msg.VoiceDuration,
+		FileUrl:       msg.FileUrl,
+		FileName:      msg.FileName,
+		FileSize:      msg.FileSize,
+		SendAt:        msg.SendAt,
+		CreatedAt:     msg.CreatedAt,
+		Attempts:      msg.Attempts,
+		LastError:     msg.LastError,
+		Failed:        msg.FailedAt != nil,
+	}
+	if msg.ReplyToId != nil {
+		res.ReplyToId = *msg.ReplyToId
+	}
+	if msg.ThreadRootId != nil {
+		res.ThreadRootId = *msg.ThreadRootId
+	}
+
+	return res
+}
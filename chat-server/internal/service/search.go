@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+)
+
+// SearchMessages ищет сообщения по тексту в чатах пользователя, от новых к старым.
+// Возвращает флаг, есть ли еще результаты
+func (s *service) SearchMessages(ctx context.Context, userId int64, filter SearchMessagesDTO) ([]SearchResultDTO, bool, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSearchLimit
+	}
+	if filter.Limit > maxSearchLimit {
+		filter.Limit = maxSearchLimit
+	}
+
+	// В конкретном чате ищем только если пользователь в нем состоит, иначе поиск сам ограничен чатами пользователя
+	if filter.ChatId > 0 {
+		inChat, err := s.ChatRepository.IsUserInChat(ctx, filter.ChatId, userId)
+		if err != nil {
+			logger.Error("failed to check user in chat", zap.Int64("chat_id", filter.ChatId), zap.Int64("user_id", userId), zap.Error(err))
+			return nil, false, fmt.Errorf("check user in chat: %w", err)
+		}
+		if !inChat {
+			return nil, false, fmt.Errorf("user %d not in chat %d: %w", userId, filter.ChatId, ErrPermissionDenied)
+		}
+	}
+
+	repoFilter := repository.SearchFilterDTO{
+		UserId:       userId,
+		Query:        filter.Query,
+		ChatId:       filter.ChatId,
+		FromUsername: filter.FromUsername,
+		DateFrom:     filter.DateFrom,
+		DateTo:       filter.DateTo,
+		BeforeId:     filter.BeforeId,
+		Limit:        filter.Limit,
+	}
+	if filter.MessageType != nil {
+		repoFilter.MessageType = messageTypeToDB(*filter.MessageType)
+	}
+
+	found, hasMore, err := s.ChatRepository.SearchMessages(ctx, repoFilter)
+	if err != nil {
+		logger.Error("failed to search messages", zap.Int64("user_id", userId), zap.Error(err))
+		return nil, false, fmt.Errorf("search messages: %w", err)
+	}
+
+	results := make([]SearchResultDTO, 0, len(found))
+	for _, r := range found {
+		results = append(results, SearchResultDTO{
+			ChatId:   r.Message.ChatId,
+			ChatName: r.ChatName,
+			Snippet:  highlightSnippet(r.Snippet),
+			Message:  toMessageDTO(r.Message),
+		})
+	}
+
+	return results, hasMore, nil
+}
+
+// highlightSnippet экранирует фрагмент и заменяет служебные границы совпадений на <mark>
+func highlightSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+
+	return strings.NewReplacer(repository.HighlightStart, "<mark>", repository.HighlightStop, "</mark>").Replace(escaped)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,16 +11,10 @@ import (
 	"go.uber.org/zap"
 )
 
-// SendMessage сохраняет сообщение и рассылает подписчикам
-func (s *service) SendMessage(ctx context.Context, msg SendMessageDTO) error {
-	msgTypeStr := "text"
-	switch msg.MessageType {
-	case MessageTypeVoice:
-		msgTypeStr = "voice"
-	case MessageTypeImage:
-		msgTypeStr = "image"
-	case MessageTypeFile:
-		msgTypeStr = "file"
+// SendMessage сохраняет сообщение, рассылает его подписчикам и возвращает отправленное сообщение
+func (s *service) SendMessage(ctx context.Context, msg SendMessageDTO) (MessageDTO, error) {
+	if _, err := s.checkPermission(ctx, msg.ChatId, msg.UserId, PermSendMessages); err != nil {
+		return MessageDTO{}, err
 	}
 
 	input := repository.MessageCreateDTO{
@@ -27,51 +22,226 @@ func (s *service) SendMessage(ctx context.Context, msg SendMessageDTO) error {
 		UserId:        msg.UserId,
 		FromUsername:  msg.FromUsername,
 		Text:          msg.Text,
-		MessageType:   msgTypeStr,
+		MessageType:   messageTypeToDB(msg.MessageType),
 		VoiceDuration: msg.VoiceDuration,
 		FileUrl:       msg.FileUrl,
 		FileName:      msg.FileName,
 		FileSize:      msg.FileSize,
 	}
+	if msg.ClientKey != "" {
+		input.ClientKey = &msg.ClientKey
+	}
+
+	// Пересланное сообщение хранит автора и чат оригинала
+	if msg.ForwardedFrom != nil {
+		input.ForwardedFrom = &repository.ForwardedFromDTO{
+			From:   msg.ForwardedFrom.From,
+			ChatId: msg.ForwardedFrom.ChatId,
+		}
+		if msg.ForwardedFrom.MessageId > 0 {
+			input.ForwardedFrom.MessageId = &msg.ForwardedFrom.MessageId
+		}
+	}
+
+	if msg.Poll != nil {
+		input.Poll = &repository.PollCreateDTO{
+			Options:        msg.Poll.Options,
+			MultipleChoice: msg.Poll.MultipleChoice,
+			Anonymous:      msg.Poll.Anonymous,
+		}
+		if !msg.Poll.ClosesAt.IsZero() {
+			input.Poll.ClosesAt = &msg.Poll.ClosesAt
+		}
+	}
+
+	// Цитируемое сообщение должно быть из этого же чата и не удалено
+	var replyTo *ReplyPreviewDTO
+	if msg.ReplyToId > 0 {
+		original, err := s.ChatRepository.MessageById(ctx, msg.ReplyToId)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return MessageDTO{}, fmt.Errorf("reply to message %d: %w", msg.ReplyToId, ErrMessageNotFound)
+			}
+			return MessageDTO{}, fmt.Errorf("get reply to message: %w", err)
+		}
+
+		if original.ChatId != msg.ChatId || original.DeletedAt != nil {
+			logger.Warn("invalid reply target", zap.Int64("chat_id", msg.ChatId), zap.Int64("reply_to_id", msg.ReplyToId))
+			return MessageDTO{}, fmt.Errorf("reply to message %d: %w", msg.ReplyToId, ErrMessageNotFound)
+		}
+
+		input.ReplyToId = &msg.ReplyToId
+		replyTo = &ReplyPreviewDTO{
+			MessageId:   original.Id,
+			From:        original.From,
+			Text:        previewText(original.Text),
+			MessageType: messageTypeFromDB(original.MessageType),
+		}
+	}
+
+	// Корень треда должен быть сообщением ленты этого же чата
+	if msg.ThreadRootId > 0 {
+		root, err := s.ChatRepository.MessageById(ctx, msg.ThreadRootId)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return MessageDTO{}, fmt.Errorf("thread root %d: %w", msg.ThreadRootId, ErrMessageNotFound)
+			}
+			return MessageDTO{}, fmt.Errorf("get thread root: %w", err)
+		}
+
+		if root.ChatId != msg.ChatId || root.DeletedAt != nil || root.ThreadRootId != nil {
+			logger.Warn("invalid thread root", zap.Int64("chat_id", msg.ChatId), zap.Int64("thread_root_id", msg.ThreadRootId))
+			return MessageDTO{}, fmt.Errorf("thread root %d: %w", msg.ThreadRootId, ErrMessageNotFound)
+		}
+
+		input.ThreadRootId = &msg.ThreadRootId
+
+		// Автор корня и отвечающие следят за тредом
+		for _, followerId := range []int64{root.UserId, msg.UserId} {
+			if err := s.UnreadRepository.FollowThread(ctx, msg.ThreadRootId, followerId); err != nil {
+				logger.Warn("failed to follow thread", zap.Int64("thread_root_id", msg.ThreadRootId), zap.Int64("user_id", followerId), zap.Error(err))
+			}
+		}
+	}
 
 	logger.Info("sending message", zap.Int64("chat_id", msg.ChatId), zap.String("sent by", msg.FromUsername))
 
 	// Сохраняем сообщение в БД
-	err := s.ChatRepository.SendMessage(ctx, input)
+	sent, err := s.ChatRepository.SendMessage(ctx, input)
 	if err != nil {
 		logger.Error("failed to save message", zap.Int64("chat_id", msg.ChatId), zap.String("sent by", msg.FromUsername), zap.Error(err))
-		return fmt.Errorf("database: failed to save message: %w", err)
+		return MessageDTO{}, fmt.Errorf("database: failed to save message: %w", err)
 	}
 
-	// Увеличиваем счетчик непрочитанных сообщений у всех кроме отправителя
-	err = s.UnreadRepository.IncrementUnreadForMembers(ctx, msg.ChatId, msg.UserId)
-	if err != nil {
-		logger.Warn("failed to increment unread count", zap.Int64("chat_id", msg.ChatId), zap.String("sent by", msg.FromUsername), zap.Error(err))
+	// Клиент повторил отправку: подписчики сообщение уже получили, отдаем сохраненное
+	if sent.Duplicate {
+		logger.Info("duplicate message send", zap.Int64("chat_id", msg.ChatId), zap.Int64("message_id", sent.Id), zap.String("client_key", msg.ClientKey))
+		return s.sentMessage(ctx, sent, msg.UserId), nil
 	}
 
-	// Создаем DTO для рассылки
+	// Непрочитанные в ленте чата считаются от указателя прочтения, для тредов увеличиваем счетчик
+	if msg.ThreadRootId > 0 {
+		err = s.UnreadRepository.IncrementThreadUnread(ctx, msg.ThreadRootId, msg.UserId)
+		if err != nil {
+			logger.Warn("failed to increment thread unread count", zap.Int64("chat_id", msg.ChatId), zap.String("sent by", msg.FromUsername), zap.Error(err))
+		}
+	}
+
+	// Упоминания сохраняем до рассылки, чтобы клиенты сразу подсветили их.
+	// В пересланном тексте упоминания адресованы участникам исходного чата
+	var mentions []int64
+	if msg.ForwardedFrom == nil {
+		mentions = s.saveMentions(ctx, msg.ChatId, sent.Id, msg.UserId, msg.Text)
+	}
+
+	// Создаем DTO для рассылки. Тип берем в том виде, в каком он сохранен в БД,
+	// иначе клиент мог бы разослать участникам служебное событие вроде удаления чата
 	msgDTO := MessageDTO{
-		MessageType:   msg.MessageType,
+		Id:            sent.Id,
+		Seq:           sent.Seq,
+		MessageType:   messageTypeFromDB(messageTypeToDB(msg.MessageType)),
 		From:          msg.FromUsername,
 		Text:          msg.Text,
-		CreatedAt:     time.Now(),
+		CreatedAt:     sent.CreatedAt,
 		VoiceDuration: msg.VoiceDuration,
 		FileUrl:       msg.FileUrl,
 		FileName:      msg.FileName,
 		FileSize:      msg.FileSize,
+		ReplyTo:       replyTo,
+		ThreadRootId:  msg.ThreadRootId,
+		ForwardedFrom: msg.ForwardedFrom,
+		Mentions:      mentions,
+	}
+	if sent.ExpiresAt != nil {
+		msgDTO.ExpiresAt = *sent.ExpiresAt
 	}
 
-	// Отправляем всем подписчикам сообщение если комната существует
-	room := s.getRoom(msg.ChatId)
-	if room != nil {
-		room.BroadcastMessage(msgDTO)
+	// Клиентам нужны id вариантов, которые выдала БД
+	if msg.Poll != nil {
+		msgDTO.Poll, err = s.loadPoll(ctx, sent.Id, msg.UserId)
+		if err != nil {
+			logger.Warn("failed to load created poll", zap.Int64("message_id", sent.Id), zap.Error(err))
+		}
+	}
+
+	// Отправляем сообщение всем подписчикам чата (или треда) на всех репликах
+	s.broadcastMessage(ctx, msg.ChatId, msgDTO)
+
+	// В ленте чата обновляем счетчик ответов у корня
+	if msg.ThreadRootId > 0 {
+		s.broadcastThreadUpdated(ctx, msg.ChatId, msg.ThreadRootId)
 	}
 
-	return nil
+	// Превью ссылки придет подписчикам отдельным событием
+	if msg.MessageType == MessageTypeText {
+		s.unfurlLink(msg.ChatId, msgDTO)
+	}
+
+	return msgDTO, nil
+}
+
+// sentMessage возвращает ранее сохраненное сообщение для повторной отправки с тем же ключом.
+// Если сообщение уже не получить, например оно удалено, отдаем только id и время отправки
+func (s *service) sentMessage(ctx context.Context, sent repository.SentMessageDTO, userId int64) MessageDTO {
+	res := MessageDTO{Id: sent.Id, Seq: sent.Seq, CreatedAt: sent.CreatedAt, Duplicate: true}
+
+	original, err := s.ChatRepository.MessageById(ctx, sent.Id)
+	if err != nil {
+		logger.Warn("failed to get duplicate message", zap.Int64("message_id", sent.Id), zap.Error(err))
+		return res
+	}
+
+	res = toMessageDTO(*original)
+	res.Duplicate = true
+
+	res.Mentions, err = s.ChatRepository.MessageMentions(ctx, sent.Id)
+	if err != nil {
+		logger.Warn("failed to get message mentions", zap.Int64("message_id", sent.Id), zap.Error(err))
+	}
+
+	if res.MessageType == MessageTypePoll {
+		res.Poll, err = s.loadPoll(ctx, sent.Id, userId)
+		if err != nil {
+			logger.Warn("failed to load poll", zap.Int64("message_id", sent.Id), zap.Error(err))
+		}
+	}
+
+	return res
+}
+
+// broadcastThreadUpdated рассылает в ленту чата актуальные счетчик и время последнего ответа корня треда
+func (s *service) broadcastThreadUpdated(ctx context.Context, chatId int64, rootId int64) {
+	root, err := s.ChatRepository.MessageById(ctx, rootId)
+	if err != nil {
+		logger.Warn("failed to get thread root", zap.Int64("thread_root_id", rootId), zap.Error(err))
+		return
+	}
+
+	event := MessageDTO{
+		Id:               rootId,
+		MessageType:      MessageTypeThreadUpdated,
+		From:             root.From,
+		CreatedAt:        root.CreatedAt,
+		ThreadReplyCount: root.ThreadReplyCount,
+	}
+	if root.ThreadLastReplyAt != nil {
+		event.ThreadLastReplyAt = *root.ThreadLastReplyAt
+	}
+
+	s.broadcastMessage(ctx, chatId, event)
+}
+
+// previewText обрезает текст для превью цитаты так же, как это делает БД
+func previewText(text string) string {
+	runes := []rune(text)
+	if len(runes) > 100 {
+		return string(runes[:100])
+	}
+	return text
 }
 
 // Функция для отправки последних сообщений
-func (s *service) sendRecentMessages(ctx context.Context, chatID int64, msgChan chan<- MessageDTO) {
+func (s *service) sendRecentMessages(ctx context.Context, chatID int64, userId int64, msgChan chan<- MessageDTO) {
 	// Если вдруг канал будет закрыт, то нужно обработать панику
 	defer func() {
 		if r := recover(); r != nil {
@@ -82,7 +252,7 @@ func (s *service) sendRecentMessages(ctx context.Context, chatID int64, msgChan
 	logger.Debug("loading chat history", zap.Int64("chat_id", chatID))
 
 	// Получаем последние 50 сообщений
-	messages, err := s.ChatRepository.RecentMessages(ctx, chatID, 50)
+	messages, err := s.ChatRepository.RecentMessages(ctx, chatID, userId, 50)
 	if err != nil {
 		logger.Error("failed to load chat history", zap.Int64("chat_id", chatID), zap.Error(err))
 
@@ -105,29 +275,18 @@ func (s *service) sendRecentMessages(ctx context.Context, chatID int64, msgChan
 		return
 	}
 
-	// Отправляем сообщения
+	history := make([]MessageDTO, 0, len(messages))
 	for _, msg := range messages {
-		msgType := MessageTypeText
-		switch msg.MessageType {
-		case "voice":
-			msgType = MessageTypeVoice
-		case "image":
-			msgType = MessageTypeImage
-		case "file":
-			msgType = MessageTypeFile
-		}
+		history = append(history, toMessageDTO(msg))
+	}
 
+	s.attachReactions(ctx, userId, history)
+	s.attachPolls(ctx, userId, history)
+
+	// Отправляем сообщения
+	for _, historyMsg := range history {
 		select {
-		case msgChan <- MessageDTO{
-			MessageType:   int32(msgType),
-			From:          msg.From,
-			Text:          msg.Text,
-			CreatedAt:     msg.CreatedAt,
-			VoiceDuration: msg.VoiceDuration,
-			FileUrl:       msg.FileUrl,
-			FileName:      msg.FileName,
-			FileSize:      msg.FileSize,
-		}:
+		case msgChan <- historyMsg:
 		case <-ctx.Done():
 			logger.Debug("history sending interrupted", zap.Int64("chat_id", chatID))
 			return
@@ -3,63 +3,153 @@ package service
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/GolZrd/micro-chat/chat-server/internal/client/grpc/auth"
+	"github.com/GolZrd/micro-chat/chat-server/internal/config"
+	"github.com/GolZrd/micro-chat/chat-server/internal/linkpreview"
 	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository/broadcast"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository/presence"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository/scheduled"
 	"github.com/GolZrd/micro-chat/chat-server/internal/repository/unread"
+	"github.com/GolZrd/micro-chat/chat-server/internal/storage"
 	"go.uber.org/zap"
 )
 
 type ChatService interface {
 	// Управление чатами
-	Create(ctx context.Context, name string, isPublic bool, creatorId int64, creatorUsername string, usernames []string) (int64, error)
-	Delete(ctx context.Context, id int64) error
+	Create(ctx context.Context, name string, isPublic bool, joinApproval bool, creatorId int64, creatorUsername string, usernames []string) (int64, error)
+	Delete(ctx context.Context, userId int64, id int64) (time.Time, error)
+	RestoreChat(ctx context.Context, userId int64, id int64) error
 	MyChats(ctx context.Context, userId int64) ([]ChatInfoDTO, error)
 	GetOrCreateDirectChat(ctx context.Context, currentUserId int64, currentUsername string, targetUserId int64, targetUsername string) (int64, bool, error)
 	AddMember(ctx context.Context, chatId int64, userId int64, username string) error
 	RemoveMember(ctx context.Context, chatId int64, userId int64, targetUserId int64, targetUsername string) error
-	JoinChat(ctx context.Context, chatId int64, userId int64, username string) error
+	SetMemberRole(ctx context.Context, userId int64, chatId int64, targetUserId int64, role string) error
+	TransferOwnership(ctx context.Context, userId int64, chatId int64, newOwnerId int64) error
+	JoinChat(ctx context.Context, chatId int64, userId int64, username string) (bool, error)
+	LeaveChat(ctx context.Context, chatId int64, userId int64, username string) error
 	PublicChats(ctx context.Context, search string) ([]PublicChatDTO, error)
 
+	// Приглашения
+	CreateInvite(ctx context.Context, userId int64, chatId int64, maxUses int32, expiresAt time.Time) (InviteDTO, error)
+	RevokeInvite(ctx context.Context, userId int64, inviteId int64) error
+	ListInvites(ctx context.Context, userId int64, chatId int64) ([]InviteDTO, error)
+	InvitePreview(ctx context.Context, userId int64, code string) (InvitePreviewDTO, error)
+	JoinByInvite(ctx context.Context, userId int64, username string, code string) (int64, bool, error)
+
+	// Заявки на вступление
+	ListJoinRequests(ctx context.Context, userId int64, chatId int64) ([]JoinRequestDTO, error)
+	ApproveJoinRequest(ctx context.Context, userId int64, requestId int64) (JoinRequestDecisionDTO, error)
+	RejectJoinRequest(ctx context.Context, userId int64, requestId int64) (JoinRequestDecisionDTO, error)
+
 	// Сообщения
-	SendMessage(ctx context.Context, msg SendMessageDTO) error
+	SendMessage(ctx context.Context, msg SendMessageDTO) (MessageDTO, error)
+	ForwardMessages(ctx context.Context, userId int64, username string, sourceChatId int64, messageIds []int64, targetChatIds []int64, clientKey string) ([]ForwardedMessageDTO, error)
+	EditMessage(ctx context.Context, userId int64, messageId int64, text string) error
+	DeleteMessage(ctx context.Context, userId int64, messageId int64, forEveryone bool) error
+	GetHistory(ctx context.Context, userId int64, chatId int64, beforeId int64, afterId int64, limit int) ([]MessageDTO, bool, error)
+	GetHistoryAround(ctx context.Context, userId int64, chatId int64, messageId int64, limit int) ([]MessageDTO, bool, bool, error)
+	GetMessagesBySeq(ctx context.Context, userId int64, chatId int64, fromSeq int64, toSeq int64) ([]MessageDTO, error)
+	SearchMessages(ctx context.Context, userId int64, filter SearchMessagesDTO) ([]SearchResultDTO, bool, error)
+	SetTyping(ctx context.Context, chatId int64, userId int64, username string, isTyping bool) error
+	AddReaction(ctx context.Context, userId int64, username string, messageId int64, emoji string) error
+	RemoveReaction(ctx context.Context, userId int64, username string, messageId int64, emoji string) error
+	SetMessageTTL(ctx context.Context, userId int64, chatId int64, ttl time.Duration) error
+
+	// Опросы
+	VotePoll(ctx context.Context, userId int64, messageId int64, optionIds []int64) (PollDTO, error)
+	ClosePoll(ctx context.Context, userId int64, messageId int64) error
+
+	// Отложенные сообщения
+	ScheduleMessage(ctx context.Context, msg SendMessageDTO, sendAt time.Time) (ScheduledMessageDTO, error)
+	ListScheduled(ctx context.Context, userId int64, chatId int64) ([]ScheduledMessageDTO, error)
+	CancelScheduled(ctx context.Context, userId int64, id int64) error
+
+	// Закрепленные сообщения
+	PinMessage(ctx context.Context, userId int64, username string, chatId int64, messageId int64) error
+	UnpinMessage(ctx context.Context, userId int64, username string, chatId int64, messageId int64) error
+	ListPinned(ctx context.Context, userId int64, chatId int64) ([]PinnedMessageDTO, error)
+
+	// Треды
+	ConnectToThread(ctx context.Context, userId int64, username string, rootId int64) (<-chan MessageDTO, error)
+	DisconnectFromThread(rootId int64, userId int64)
+	GetThread(ctx context.Context, userId int64, rootId int64, beforeId int64, afterId int64, limit int) (MessageDTO, []MessageDTO, bool, error)
+	MarkThreadRead(ctx context.Context, rootId, userId int64) error
+	ThreadUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error)
 	// Подключение к чату
 	ConnectToChat(ctx context.Context, userId int64, username string, chatID int64) (<-chan MessageDTO, error)
 	DisconnectFromChat(chatId int64, userId int64)
 
-	// Онлайн статусы в чате
-	OnlineUsers(chatID int64) []OnlineUserDTO
-	OnlineCount(chatID int64) int
-	IsUserOnline(chatID int64, userID int64) bool
+	// Онлайн статусы в чате (по всем репликам)
+	OnlineUsers(ctx context.Context, chatID int64) []OnlineUserDTO
+	OnlineCount(ctx context.Context, chatID int64) int
+	IsUserOnline(ctx context.Context, chatID int64, userID int64) bool
 
 	// Присутствие
 	Heartbeat(ctx context.Context, userId int64) error
 	FriendsPresence(ctx context.Context, userIds []int64) ([]FriendPresenceDTO, error)
 
 	// Непрочитанные сообщения
-	MarkChatRead(ctx context.Context, chatId, userId int64) error
+	MarkChatRead(ctx context.Context, chatId, userId int64, username string, upToMessageId int64) error
 	UnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error)
+	MentionCounts(ctx context.Context, userId int64) (map[int64]int32, error)
+	GetReadReceipts(ctx context.Context, userId int64, chatId int64, messageId int64) ([]ReadReceiptDTO, error)
+
+	// Фоновые задачи
+	RunBroadcastListener(ctx context.Context) error
+	RunScheduledDispatcher(ctx context.Context) error
+	RunMessageReaper(ctx context.Context) error
 }
 
 type service struct {
-	ChatRepository     repository.ChatRepository
-	PresenceRepository presence.RedisRepository
-	UnreadRepository   unread.UnreadRepository
-	authClient         *auth.Client
-
-	rooms   map[int64]*ChatRoom // chat_id → ChatRoom
-	roomsMu sync.RWMutex        // мьютекс для создания и удаления комнат
+	ChatRepository      repository.ChatRepository
+	PresenceRepository  presence.RedisRepository
+	UnreadRepository    unread.UnreadRepository
+	ScheduledRepository scheduled.ScheduledRepository
+	BroadcastBus        broadcast.Bus
+	FileStorage         storage.Storage
+	LinkPreviews        linkpreview.Fetcher
+	authClient          *auth.Client
+
+	editWindow        time.Duration
+	scheduledInterval time.Duration // Как часто диспетчер проверяет отложенные сообщения
+	reaperInterval    time.Duration // Как часто удаляются истекшие сообщения
+	restoreWindow     time.Duration // Сколько удаленный чат можно восстановить
+
+	// Локальные комнаты этой реплики, события с других реплик приходят через BroadcastBus
+	rooms       map[int64]*ChatRoom   // chat_id → ChatRoom
+	threadRooms map[int64]*threadRoom // id корня треда → комната подписчиков треда
+	roomsMu     sync.RWMutex          // мьютекс для создания и удаления комнат
+
+	// Таймеры сброса "печатает" для пользователей, приславших событие на эту реплику
+	typing   map[typingKey]*typingState
+	typingMu sync.Mutex
+
+	// Ограничивает число одновременных загрузок превью ссылок
+	previewSlots chan struct{}
 }
 
-func NewService(chatRepository repository.ChatRepository, presenceRepo presence.RedisRepository, unreadRepo unread.UnreadRepository, authClient *auth.Client) ChatService {
+func NewService(chatRepository repository.ChatRepository, presenceRepo presence.RedisRepository, unreadRepo unread.UnreadRepository, scheduledRepo scheduled.ScheduledRepository, broadcastBus broadcast.Bus, fileStorage storage.Storage, linkPreviews linkpreview.Fetcher, authClient *auth.Client, cfg *config.Config) ChatService {
 	return &service{
-		ChatRepository:     chatRepository,
-		PresenceRepository: presenceRepo,
-		UnreadRepository:   unreadRepo,
-		authClient:         authClient,
-		rooms:              make(map[int64]*ChatRoom),
+		ChatRepository:      chatRepository,
+		PresenceRepository:  presenceRepo,
+		UnreadRepository:    unreadRepo,
+		ScheduledRepository: scheduledRepo,
+		BroadcastBus:        broadcastBus,
+		FileStorage:         fileStorage,
+		LinkPreviews:        linkPreviews,
+		authClient:          authClient,
+		editWindow:          cfg.MessageEditWindow,
+		scheduledInterval:   cfg.ScheduledDispatchInterval,
+		reaperInterval:      cfg.MessageReaperInterval,
+		restoreWindow:       cfg.ChatRestoreWindow,
+		rooms:               make(map[int64]*ChatRoom),
+		threadRooms:         make(map[int64]*threadRoom),
+		typing:              make(map[typingKey]*typingState),
+		previewSlots:        make(chan struct{}, maxConcurrentPreviews),
 	}
 }
 
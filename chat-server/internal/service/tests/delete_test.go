@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCanDelete(t *testing.T) {
+	direct := &repository.ChatInfoDTO{
+		Id:       1,
+		IsDirect: true,
+		Members: []repository.MemberDTO{
+			{UserId: 1, Username: "alice", Role: service.RoleMember},
+			{UserId: 2, Username: "bob", Role: service.RoleMember},
+		},
+	}
+
+	group := &repository.ChatInfoDTO{
+		Id: 2,
+		Members: []repository.MemberDTO{
+			{UserId: 1, Username: "alice", Role: service.RoleOwner},
+			{UserId: 2, Username: "bob", Role: service.RoleAdmin},
+			{UserId: 3, Username: "carol", Role: service.RoleModerator},
+			{UserId: 4, Username: "dave", Role: service.RoleMember},
+			{UserId: 5, Username: "eve", Role: service.RoleReadOnly},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		chat        *repository.ChatInfoDTO
+		userId      int64
+		expectedErr error
+	}{
+		{
+			name:   "success case - direct chat, first member",
+			chat:   direct,
+			userId: 1,
+		},
+		{
+			name:   "success case - direct chat, second member",
+			chat:   direct,
+			userId: 2,
+		},
+		{
+			name:        "error case - direct chat, not a member",
+			chat:        direct,
+			userId:      3,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:   "success case - group chat, owner",
+			chat:   group,
+			userId: 1,
+		},
+		{
+			name:        "error case - group chat, admin",
+			chat:        group,
+			userId:      2,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - group chat, moderator",
+			chat:        group,
+			userId:      3,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - group chat, member",
+			chat:        group,
+			userId:      4,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - group chat, read only",
+			chat:        group,
+			userId:      5,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - group chat, not a member",
+			chat:        group,
+			userId:      99,
+			expectedErr: service.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.CheckCanDelete(tt.chat, tt.userId)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
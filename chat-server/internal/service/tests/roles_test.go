@@ -0,0 +1,300 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/config"
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"github.com/GolZrd/micro-chat/chat-server/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func init() {
+	logger.Init(zapcore.NewNopCore())
+}
+
+var allPermissions = map[string]service.Permission{
+	"send_messages":   service.PermSendMessages,
+	"react":           service.PermReact,
+	"add_members":     service.PermAddMembers,
+	"remove_members":  service.PermRemoveMembers,
+	"pin_messages":    service.PermPinMessages,
+	"delete_messages": service.PermDeleteMessages,
+	"edit_chat_info":  service.PermEditChatInfo,
+	"manage_roles":    service.PermManageRoles,
+}
+
+func TestCan(t *testing.T) {
+	// Матрица выписана целиком: любое изменение прав роли должно быть осознанным
+	tests := []struct {
+		role    string
+		allowed []service.Permission
+	}{
+		{
+			role: service.RoleOwner,
+			allowed: []service.Permission{
+				service.PermSendMessages, service.PermReact, service.PermAddMembers, service.PermRemoveMembers,
+				service.PermPinMessages, service.PermDeleteMessages, service.PermEditChatInfo, service.PermManageRoles,
+			},
+		},
+		{
+			role: service.RoleAdmin,
+			allowed: []service.Permission{
+				service.PermSendMessages, service.PermReact, service.PermAddMembers, service.PermRemoveMembers,
+				service.PermPinMessages, service.PermDeleteMessages, service.PermEditChatInfo, service.PermManageRoles,
+			},
+		},
+		{
+			role: service.RoleModerator,
+			allowed: []service.Permission{
+				service.PermSendMessages, service.PermReact, service.PermAddMembers, service.PermRemoveMembers,
+				service.PermPinMessages, service.PermDeleteMessages,
+			},
+		},
+		{
+			role:    service.RoleMember,
+			allowed: []service.Permission{service.PermSendMessages, service.PermReact},
+		},
+		{
+			role:    service.RoleReadOnly,
+			allowed: []service.Permission{service.PermReact},
+		},
+		{
+			role: "unknown",
+		},
+		{
+			role: "",
+		},
+	}
+
+	for _, tt := range tests {
+		for name, perm := range allPermissions {
+			t.Run(tt.role+"/"+name, func(t *testing.T) {
+				require.Equal(t, contains(tt.allowed, perm), service.Can(tt.role, perm))
+			})
+		}
+	}
+}
+
+func TestOutranks(t *testing.T) {
+	ranked := []string{service.RoleOwner, service.RoleAdmin, service.RoleModerator, service.RoleMember, service.RoleReadOnly}
+
+	for i, role := range ranked {
+		for j, other := range ranked {
+			t.Run(role+"/"+other, func(t *testing.T) {
+				// Роли перечислены от старшей к младшей
+				require.Equal(t, i < j, service.Outranks(role, other))
+			})
+		}
+	}
+
+	t.Run("unknown role outranks nobody", func(t *testing.T) {
+		require.False(t, service.Outranks("unknown", service.RoleReadOnly))
+		require.True(t, service.Outranks(service.RoleReadOnly, "unknown"))
+	})
+}
+
+func TestSetMemberRole(t *testing.T) {
+	const (
+		chatId     int64 = 10
+		ownerId    int64 = 1
+		adminId    int64 = 2
+		admin2Id   int64 = 3
+		modId      int64 = 4
+		memberId   int64 = 5
+		readerId   int64 = 6
+		strangerId int64 = 99
+	)
+
+	roles := map[int64]string{
+		ownerId:  service.RoleOwner,
+		adminId:  service.RoleAdmin,
+		admin2Id: service.RoleAdmin,
+		modId:    service.RoleModerator,
+		memberId: service.RoleMember,
+		readerId: service.RoleReadOnly,
+	}
+
+	tests := []struct {
+		name        string
+		isDirect    bool
+		userId      int64
+		targetId    int64
+		role        string
+		expectedErr error
+		expectSet   bool
+	}{
+		{
+			name:      "success case - owner promotes member to admin",
+			userId:    ownerId,
+			targetId:  memberId,
+			role:      service.RoleAdmin,
+			expectSet: true,
+		},
+		{
+			name:      "success case - admin promotes member to moderator",
+			userId:    adminId,
+			targetId:  memberId,
+			role:      service.RoleModerator,
+			expectSet: true,
+		},
+		{
+			name:      "success case - admin makes member read only",
+			userId:    adminId,
+			targetId:  memberId,
+			role:      service.RoleReadOnly,
+			expectSet: true,
+		},
+		{
+			name:      "success case - owner demotes admin",
+			userId:    ownerId,
+			targetId:  adminId,
+			role:      service.RoleMember,
+			expectSet: true,
+		},
+		{
+			name:     "success case - same role is a no-op",
+			userId:   ownerId,
+			targetId: modId,
+			role:     service.RoleModerator,
+		},
+		{
+			name:        "error case - admin cannot promote to admin",
+			userId:      adminId,
+			targetId:    memberId,
+			role:        service.RoleAdmin,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - admin cannot demote owner",
+			userId:      adminId,
+			targetId:    ownerId,
+			role:        service.RoleMember,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - admin cannot demote another admin",
+			userId:      adminId,
+			targetId:    admin2Id,
+			role:        service.RoleMember,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - moderator cannot manage roles",
+			userId:      modId,
+			targetId:    memberId,
+			role:        service.RoleReadOnly,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - member cannot manage roles",
+			userId:      memberId,
+			targetId:    readerId,
+			role:        service.RoleMember,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - not a member of the chat",
+			userId:      strangerId,
+			targetId:    memberId,
+			role:        service.RoleReadOnly,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - cannot change own role",
+			userId:      adminId,
+			targetId:    adminId,
+			role:        service.RoleMember,
+			expectedErr: service.ErrPermissionDenied,
+		},
+		{
+			name:        "error case - owner role is only transferred",
+			userId:      ownerId,
+			targetId:    adminId,
+			role:        service.RoleOwner,
+			expectedErr: service.ErrInvalidRole,
+		},
+		{
+			name:        "error case - unknown role",
+			userId:      ownerId,
+			targetId:    memberId,
+			role:        "superuser",
+			expectedErr: service.ErrInvalidRole,
+		},
+		{
+			name:        "error case - target not in chat",
+			userId:      ownerId,
+			targetId:    strangerId,
+			role:        service.RoleMember,
+			expectedErr: service.ErrMemberNotFound,
+		},
+		{
+			name:        "error case - direct chat has no roles",
+			isDirect:    true,
+			userId:      ownerId,
+			targetId:    memberId,
+			role:        service.RoleAdmin,
+			expectedErr: service.ErrDirectChat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &rolesRepoStub{
+				chat:  &repository.ChatInfoDTO{Id: chatId, IsDirect: tt.isDirect},
+				roles: roles,
+			}
+			svc := service.NewService(repo, nil, nil, nil, nil, nil, nil, nil, &config.Config{})
+
+			err := svc.SetMemberRole(context.Background(), tt.userId, chatId, tt.targetId, tt.role)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectSet, repo.setCalled)
+		})
+	}
+}
+
+// rolesRepoStub хранит роли участников одного чата. Остальные методы репозитория в тестах ролей не вызываются
+type rolesRepoStub struct {
+	repository.ChatRepository
+
+	chat      *repository.ChatInfoDTO
+	roles     map[int64]string
+	setCalled bool
+}
+
+func (r *rolesRepoStub) ChatInfo(_ context.Context, chatId int64) (*repository.ChatInfoDTO, error) {
+	if chatId != r.chat.Id {
+		return nil, repository.ErrNotFound
+	}
+	return r.chat, nil
+}
+
+func (r *rolesRepoStub) MemberRole(_ context.Context, chatId int64, userId int64) (string, error) {
+	role, ok := r.roles[userId]
+	if !ok || chatId != r.chat.Id {
+		return "", repository.ErrNotFound
+	}
+	return role, nil
+}
+
+func (r *rolesRepoStub) SetMemberRole(_ context.Context, _ int64, _ int64, _ string, _ string) error {
+	r.setCalled = true
+	return nil
+}
+
+func contains(perms []service.Permission, perm service.Permission) bool {
+	for _, p := range perms {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// ConnectToThread подписывает на новые сообщения треда без подписки на весь чат.
+// История треда загружается через GetThread, в канал приходят только новые события.
+func (s *service) ConnectToThread(ctx context.Context, userId int64, username string, rootId int64) (<-chan MessageDTO, error) {
+	root, err := s.threadRoot(ctx, userId, rootId)
+	if err != nil {
+		return nil, err
+	}
+
+	room := s.getOrCreateThreadRoom(root.ChatId, rootId)
+
+	msgChan := make(chan MessageDTO, 100)
+	sub := &Subscriber{
+		Channel:  msgChan,
+		UserId:   userId,
+		Username: username,
+		JoinedAt: time.Now(),
+	}
+
+	oldChannel := room.AddSubscriber(sub)
+
+	// Закрываем старое соединение если было
+	if oldChannel != nil {
+		close(oldChannel)
+		logger.Info("closed old thread connection", zap.Int64("thread_root_id", rootId), zap.Int64("user_id", userId))
+	}
+
+	// Открыл тред - значит следит за ним и все прочитал
+	err = s.UnreadRepository.MarkThreadRead(ctx, rootId, userId)
+	if err != nil {
+		logger.Warn("failed to mark thread as read", zap.Int64("thread_root_id", rootId), zap.Int64("user_id", userId), zap.Error(err))
+	}
+
+	logger.Info("thread subscriber connected", zap.Int64("chat_id", root.ChatId), zap.Int64("thread_root_id", rootId), zap.Int64("user_id", userId))
+
+	return msgChan, nil
+}
+
+// DisconnectFromThread отписывает от треда
+func (s *service) DisconnectFromThread(rootId int64, userId int64) {
+	room := s.getThreadRoom(rootId)
+	if room == nil {
+		return
+	}
+
+	channel, isEmpty := room.RemoveSubscriber(userId)
+	if channel == nil {
+		return // Подписчик не был в комнате
+	}
+
+	close(channel)
+
+	logger.Info("thread subscriber disconnected", zap.Int64("thread_root_id", rootId), zap.Int64("user_id", userId))
+
+	if isEmpty {
+		s.deleteThreadRoomIfEmpty(rootId)
+	}
+}
+
+// GetThread возвращает корень треда и страницу ответов
+func (s *service) GetThread(ctx context.Context, userId int64, rootId int64, beforeId int64, afterId int64, limit int) (MessageDTO, []MessageDTO, bool, error) {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	root, err := s.threadRoot(ctx, userId, rootId)
+	if err != nil {
+		return MessageDTO{}, nil, false, err
+	}
+
+	replies, hasMore, err := s.ChatRepository.ThreadHistory(ctx, rootId, userId, beforeId, afterId, limit)
+	if err != nil {
+		logger.Error("failed to load thread", zap.Int64("thread_root_id", rootId), zap.Error(err))
+		return MessageDTO{}, nil, false, fmt.Errorf("load thread: %w", err)
+	}
+
+	// Корень идет первым, чтобы реакции загрузить одним запросом
+	res := make([]MessageDTO, 0, len(replies)+1)
+	res = append(res, toMessageDTO(*root))
+	for _, msg := range replies {
+		res = append(res, toMessageDTO(msg))
+	}
+
+	s.attachReactions(ctx, userId, res)
+	s.attachPolls(ctx, userId, res)
+
+	return res[0], res[1:], hasMore, nil
+}
+
+// MarkThreadRead обнуляет непрочитанные в треде
+func (s *service) MarkThreadRead(ctx context.Context, rootId, userId int64) error {
+	if _, err := s.threadRoot(ctx, userId, rootId); err != nil {
+		return err
+	}
+
+	err := s.UnreadRepository.MarkThreadRead(ctx, rootId, userId)
+	if err != nil {
+		logger.Error("failed to mark thread as read", zap.Int64("thread_root_id", rootId), zap.Int64("user_id", userId), zap.Error(err))
+		return fmt.Errorf("mark thread as read: %w", err)
+	}
+	return nil
+}
+
+// ThreadUnreadCounts возвращает непрочитанные по тредам, на которые подписан пользователь
+func (s *service) ThreadUnreadCounts(ctx context.Context, userId int64) (map[int64]int32, error) {
+	res, err := s.UnreadRepository.AllThreadUnreadCounts(ctx, userId)
+	if err != nil {
+		logger.Error("failed to get thread unread counts", zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("get thread unread counts: %w", err)
+	}
+	return res, nil
+}
+
+// threadRoot проверяет что сообщение может быть корнем треда и пользователь состоит в его чате
+func (s *service) threadRoot(ctx context.Context, userId int64, rootId int64) (*repository.MessageDTO, error) {
+	root, err := s.ChatRepository.MessageById(ctx, rootId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("thread root %d: %w", rootId, ErrMessageNotFound)
+		}
+		logger.Error("failed to get thread root", zap.Int64("thread_root_id", rootId), zap.Error(err))
+		return nil, fmt.Errorf("get thread root: %w", err)
+	}
+
+	// Ответ в треде сам не может быть корнем, вложенных тредов нет
+	if root.ThreadRootId != nil {
+		return nil, fmt.Errorf("message %d is a thread reply: %w", rootId, ErrMessageNotFound)
+	}
+
+	inChat, err := s.ChatRepository.IsUserInChat(ctx, root.ChatId, userId)
+	if err != nil {
+		logger.Error("failed to check user in chat", zap.Int64("chat_id", root.ChatId), zap.Int64("user_id", userId), zap.Error(err))
+		return nil, fmt.Errorf("check user in chat: %w", err)
+	}
+	if !inChat {
+		logger.Warn("user not in chat", zap.Int64("chat_id", root.ChatId), zap.Int64("user_id", userId))
+		return nil, fmt.Errorf("user %d not in chat %d: %w", userId, root.ChatId, ErrPermissionDenied)
+	}
+
+	return root, nil
+}
+
+// threadRoom комната подписчиков треда. Чат запоминаем, чтобы закрыть треды вместе с чатом
+type threadRoom struct {
+	*ChatRoom
+	chatId int64
+}
+
+// getThreadRoom возвращает комнату треда без создания
+func (s *service) getThreadRoom(rootId int64) *ChatRoom {
+	s.roomsMu.RLock()
+	defer s.roomsMu.RUnlock()
+
+	room, exists := s.threadRooms[rootId]
+	if !exists {
+		return nil
+	}
+	return room.ChatRoom
+}
+
+// getOrCreateThreadRoom возвращает комнату треда или создает новую
+func (s *service) getOrCreateThreadRoom(chatId int64, rootId int64) *ChatRoom {
+	s.roomsMu.Lock()
+	defer s.roomsMu.Unlock()
+
+	room, exists := s.threadRooms[rootId]
+	if !exists {
+		room = &threadRoom{ChatRoom: newChatRoom(), chatId: chatId}
+		s.threadRooms[rootId] = room
+	}
+
+	return room.ChatRoom
+}
+
+func (s *service) deleteThreadRoomIfEmpty(rootId int64) {
+	s.roomsMu.Lock()
+	defer s.roomsMu.Unlock()
+
+	room, exists := s.threadRooms[rootId]
+	if exists && room.IsEmpty() {
+		delete(s.threadRooms, rootId)
+	}
+}
+
+// closeThreadRooms отключает всех подписчиков тредов чата на этой реплике
+func (s *service) closeThreadRooms(chatId int64) {
+	var closed []*ChatRoom
+
+	s.roomsMu.Lock()
+	for rootId, room := range s.threadRooms {
+		if room.chatId == chatId {
+			closed = append(closed, room.ChatRoom)
+			delete(s.threadRooms, rootId)
+		}
+	}
+	s.roomsMu.Unlock()
+
+	for _, room := range closed {
+		for _, sub := range room.RemoveAll() {
+			close(sub.Channel)
+		}
+	}
+
+	if len(closed) > 0 {
+		logger.Info("thread rooms closed", zap.Int64("chat_id", chatId), zap.Int("count", len(closed)))
+	}
+}
+
+// disconnectFromThreads отписывает пользователя от всех тредов чата на этой реплике
+func (s *service) disconnectFromThreads(chatId int64, userId int64) {
+	var rootIds []int64
+
+	s.roomsMu.RLock()
+	for rootId, room := range s.threadRooms {
+		if room.chatId == chatId {
+			rootIds = append(rootIds, rootId)
+		}
+	}
+	s.roomsMu.RUnlock()
+
+	for _, rootId := range rootIds {
+		s.DisconnectFromThread(rootId, userId)
+	}
+}
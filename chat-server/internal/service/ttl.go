@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GolZrd/micro-chat/chat-server/internal/logger"
+	"github.com/GolZrd/micro-chat/chat-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// reaperBatchSize сколько истекших сообщений удаляется за один запрос
+const reaperBatchSize = 500
+
+// SetMessageTTL задает время жизни новых сообщений чата, 0 - сообщения не исчезают.
+// В групповом чате это могут роли с правом менять настройки чата, в личном - любой из собеседников
+func (s *service) SetMessageTTL(ctx context.Context, userId int64, chatId int64, ttl time.Duration) error {
+	role, err := s.ChatRepository.MemberRole(ctx, chatId, userId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			logger.Warn("user not in chat", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId))
+			return fmt.Errorf("user %d not in chat %d: %w", userId, chatId, ErrPermissionDenied)
+		}
+		return fmt.Errorf("get member role: %w", err)
+	}
+
+	if !Can(role, PermEditChatInfo) {
+		chat, err := s.ChatRepository.ChatInfo(ctx, chatId)
+		if err != nil {
+			return fmt.Errorf("get chat info: %w", err)
+		}
+		if !chat.IsDirect {
+			logger.Warn("user is not allowed to change message ttl", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.String("role", role))
+			return fmt.Errorf("role %q cannot change message ttl: %w", role, ErrPermissionDenied)
+		}
+	}
+
+	err = s.ChatRepository.SetMessageTTL(ctx, chatId, int32(ttl/time.Second))
+	if err != nil {
+		logger.Error("failed to set message ttl", zap.Int64("chat_id", chatId), zap.Error(err))
+		return fmt.Errorf("set message ttl: %w", err)
+	}
+
+	logger.Info("message ttl changed", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.Duration("ttl", ttl))
+
+	return nil
+}
+
+// RunMessageReaper периодически удаляет истекшие сообщения и их файлы и сообщает об этом подписчикам.
+// До удаления истекшие сообщения уже не отдаются запросами чтения. Заодно стирает удаленные чаты, у которых истекло окно восстановления
+func (s *service) RunMessageReaper(ctx context.Context) error {
+	logger.Info("message reaper started", zap.Duration("interval", s.reaperInterval))
+
+	ticker := time.NewTicker(s.reaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			s.reapExpired(ctx)
+			s.purgeDeletedChats(ctx)
+		}
+	}
+}
+
+// reapExpired удаляет истекшие сообщения пачками, пока они есть
+func (s *service) reapExpired(ctx context.Context) {
+	for ctx.Err() == nil {
+		purged, err := s.ChatRepository.PurgeExpiredMessages(ctx, reaperBatchSize)
+		if err != nil {
+			logger.Error("failed to purge expired messages", zap.Error(err))
+		}
+		if len(purged) == 0 {
+			return
+		}
+
+		logger.Info("expired messages purged", zap.Int("count", len(purged)))
+
+		s.removeUnusedFiles(ctx, purged)
+
+		// Ответы в треде уходят подписчикам треда, корень и сообщения ленты - подписчикам чата
+		rootsUpdated := make(map[int64]int64)
+		for _, msg := range purged {
+			event := MessageDTO{
+				Id:          msg.Id,
+				MessageType: MessageTypeExpired,
+				CreatedAt:   time.Now(),
+			}
+			if msg.ThreadRootId != nil {
+				event.ThreadRootId = *msg.ThreadRootId
+				rootsUpdated[*msg.ThreadRootId] = msg.ChatId
+			}
+
+			s.broadcastMessage(ctx, msg.ChatId, event)
+		}
+
+		// У оставшихся корней изменился счетчик ответов
+		for rootId, chatId := range rootsUpdated {
+			s.broadcastThreadUpdated(ctx, chatId, rootId)
+		}
+
+		if len(purged) < reaperBatchSize || err != nil {
+			return
+		}
+	}
+}
+
+// removeUnusedFiles удаляет из хранилища файлы удаленных сообщений, если на них больше никто не ссылается
+func (s *service) removeUnusedFiles(ctx context.Context, purged []repository.ExpiredMessageDTO) {
+	var urls []string
+	for _, msg := range purged {
+		if msg.FileUrl != "" {
+			urls = append(urls, msg.FileUrl)
+		}
+	}
+	if len(urls) == 0 {
+		return
+	}
+
+	// Пересланные копии ссылаются на тот же файл
+	inUse, err := s.ChatRepository.FileUrlsInUse(ctx, urls)
+	if err != nil {
+		logger.Error("failed to check files in use", zap.Error(err))
+		return
+	}
+
+	unused := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if !inUse[url] {
+			unused = append(unused, url)
+		}
+	}
+	if len(unused) == 0 {
+		return
+	}
+
+	if err := s.FileStorage.Remove(ctx, unused); err != nil {
+		logger.Warn("failed to remove expired files", zap.Int("count", len(unused)), zap.Error(err))
+	}
+}
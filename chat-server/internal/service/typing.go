@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// typingTTL сколько держится "печатает" без повторного события, клиент обновляет его пока печатает
+const typingTTL = 6 * time.Second
+
+type typingKey struct {
+	chatId int64
+	userId int64
+}
+
+type typingState struct {
+	timer    *time.Timer
+	username string
+}
+
+// SetTyping рассылает участникам чата что пользователь начал или перестал печатать.
+// Если клиент замолчал, через typingTTL автоматически рассылается остановка
+func (s *service) SetTyping(ctx context.Context, chatId int64, userId int64, username string, isTyping bool) error {
+	// Печатать могут только те, кому можно писать в чат
+	if _, err := s.checkPermission(ctx, chatId, userId, PermSendMessages); err != nil {
+		return err
+	}
+
+	key := typingKey{chatId: chatId, userId: userId}
+
+	if isTyping {
+		s.typingMu.Lock()
+		state, exists := s.typing[key]
+		if exists {
+			state.timer.Reset(typingTTL)
+		} else {
+			state = &typingState{username: username}
+			state.timer = time.AfterFunc(typingTTL, func() { s.expireTyping(key, state) })
+			s.typing[key] = state
+		}
+		s.typingMu.Unlock()
+
+		s.broadcastTyping(ctx, key, username, true)
+		return nil
+	}
+
+	// Остановку рассылаем даже без таймера, старт мог прийти на другую реплику
+	if !s.stopTyping(key) {
+		s.broadcastTyping(ctx, key, username, false)
+	}
+	return nil
+}
+
+// stopTyping снимает таймер и рассылает остановку, возвращает false если пользователь не печатал через эту реплику
+func (s *service) stopTyping(key typingKey) bool {
+	s.typingMu.Lock()
+	state, exists := s.typing[key]
+	if exists {
+		state.timer.Stop()
+		delete(s.typing, key)
+	}
+	s.typingMu.Unlock()
+
+	if !exists {
+		return false
+	}
+
+	s.broadcastTyping(context.Background(), key, state.username, false)
+	return true
+}
+
+// expireTyping срабатывает по таймеру, если клиент замолчал
+func (s *service) expireTyping(key typingKey, state *typingState) {
+	s.typingMu.Lock()
+	// Состояние могли уже снять и завести заново, тогда этот таймер устарел
+	if s.typing[key] != state {
+		s.typingMu.Unlock()
+		return
+	}
+	delete(s.typing, key)
+	s.typingMu.Unlock()
+
+	s.broadcastTyping(context.Background(), key, state.username, false)
+}
+
+func (s *service) broadcastTyping(ctx context.Context, key typingKey, username string, isTyping bool) {
+	now := time.Now()
+
+	event := MessageDTO{
+		MessageType: MessageTypeTyping,
+		From:        username,
+		CreatedAt:   now,
+		Typing: &TypingDTO{
+			UserId:   key.userId,
+			Username: username,
+			IsTyping: isTyping,
+		},
+	}
+	if isTyping {
+		event.Typing.ExpiresAt = now.Add(typingTTL)
+	}
+
+	s.broadcastMessage(ctx, key.chatId, event)
+}
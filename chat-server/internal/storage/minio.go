@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Storage - файлы сообщений в MinIO. Загружает их web-gateway, chat-server только удаляет
+type Storage interface {
+	Remove(ctx context.Context, fileUrls []string) error
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+type minioStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewMinioStorage(endpoint, accessKey, secretKey, bucket string) (Storage, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: false, // Пока не используем SSL
+	})
+	if err != nil {
+		return nil, fmt.Errorf("minio connect: %w", err)
+	}
+
+	return &minioStorage{
+		client: client,
+		bucket: bucket,
+	}, nil
+}
+
+// Remove удаляет файлы по их url вида /files/<bucket>/<object>, чужие url пропускаются
+func (s *minioStorage) Remove(ctx context.Context, fileUrls []string) error {
+	prefix := fmt.Sprintf("/files/%s/", s.bucket)
+
+	objects := make(chan minio.ObjectInfo, len(fileUrls))
+	for _, url := range fileUrls {
+		name, ok := strings.CutPrefix(url, prefix)
+		if !ok || name == "" {
+			continue
+		}
+		objects <- minio.ObjectInfo{Key: name}
+	}
+	close(objects)
+
+	var errs []error
+	for res := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		errs = append(errs, fmt.Errorf("remove %s: %w", res.ObjectName, res.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// List возвращает url всех файлов, имя которых начинается с prefix
+func (s *minioStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	var urls []string
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, fmt.Errorf("list %s: %w", prefix, obj.Err)
+		}
+		urls = append(urls, fmt.Sprintf("/files/%s/%s", s.bucket, obj.Key))
+	}
+
+	return urls, nil
+}
@@ -0,0 +1,2 @@
+drop table message_revisions;
+ALTER TABLE messages DROP COLUMN edited_at;
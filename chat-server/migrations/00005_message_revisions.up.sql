@@ -0,0 +1,13 @@
+-- Время последнего редактирования, NULL если сообщение не редактировалось
+ALTER TABLE messages ADD COLUMN edited_at TIMESTAMP;
+
+-- Предыдущие версии текста сообщения
+CREATE TABLE message_revisions (
+    ID BIGSERIAL PRIMARY KEY,
+    message_id BIGINT NOT NULL REFERENCES messages(ID) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    edited_by BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_message_revisions_message ON message_revisions(message_id, created_at DESC);
@@ -0,0 +1,2 @@
+drop table message_hidden;
+ALTER TABLE messages DROP COLUMN deleted_at;
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- Удаление "для всех": сообщение остается в ленте как заглушка, чтобы не ломать порядок
+ALTER TABLE messages ADD COLUMN deleted_at TIMESTAMP;
+
+-- Удаление "для себя": сообщение скрыто только у конкретного пользователя
+CREATE TABLE message_hidden (
+    message_id BIGINT NOT NULL REFERENCES messages(ID) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    hidden_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, user_id)
+);
+
+CREATE INDEX idx_message_hidden_user ON message_hidden(user_id);
@@ -0,0 +1,2 @@
+DROP INDEX idx_messages_chat_created;
+CREATE INDEX idx_messages_chat_created ON messages(chat_id, created_at DESC);
\ No newline at end of file
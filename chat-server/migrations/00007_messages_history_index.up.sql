@@ -0,0 +1,3 @@
+-- Расширяем индекс истории чата до курсора (created_at, id) для постраничной загрузки
+DROP INDEX idx_messages_chat_created;
+CREATE INDEX idx_messages_chat_created ON messages(chat_id, created_at DESC, id DESC);
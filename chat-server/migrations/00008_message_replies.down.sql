@@ -0,0 +1 @@
+ALTER TABLE messages DROP COLUMN reply_to_id;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Ответ на сообщение, превью цитаты собирается при чтении, поэтому всегда актуально
+ALTER TABLE messages ADD COLUMN reply_to_id BIGINT REFERENCES messages(ID) ON DELETE SET NULL;
\ No newline at end of file
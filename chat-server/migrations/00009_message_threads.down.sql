@@ -0,0 +1,4 @@
+drop table thread_unread;
+ALTER TABLE messages DROP COLUMN thread_last_reply_at;
+ALTER TABLE messages DROP COLUMN thread_reply_count;
+ALTER TABLE messages DROP COLUMN thread_root_id;
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- Треды: ответы в треде хранят корневое сообщение, у корня денормализованы счетчик и время последнего ответа
+ALTER TABLE messages ADD COLUMN thread_root_id BIGINT REFERENCES messages(ID) ON DELETE CASCADE;
+ALTER TABLE messages ADD COLUMN thread_reply_count INT NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN thread_last_reply_at TIMESTAMP;
+
+CREATE INDEX idx_messages_thread ON messages(thread_root_id, created_at DESC, id DESC) WHERE thread_root_id IS NOT NULL;
+
+-- Непрочитанные в тредах, запись есть у каждого кто следит за тредом
+CREATE TABLE thread_unread (
+    thread_root_id BIGINT NOT NULL REFERENCES messages(ID) ON DELETE CASCADE,
+    user_id        BIGINT NOT NULL,
+    count          INT NOT NULL DEFAULT 0,
+    last_read_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (thread_root_id, user_id)
+);
+
+CREATE INDEX idx_thread_unread_user_nonzero ON thread_unread(user_id) WHERE count > 0;
@@ -0,0 +1,10 @@
+-- Реакции на сообщения, один пользователь может поставить несколько разных эмодзи
+CREATE TABLE message_reactions (
+    message_id BIGINT NOT NULL REFERENCES messages(ID) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    emoji VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, user_id, emoji)
+);
+
+CREATE INDEX idx_message_reactions_message ON message_reactions(message_id);
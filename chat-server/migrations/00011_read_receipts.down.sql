@@ -0,0 +1,3 @@
+ALTER TABLE chat_unread ADD COLUMN count INT NOT NULL DEFAULT 0;
+CREATE INDEX idx_chat_unread_user_nonzero ON chat_unread(user_id) WHERE count > 0;
+ALTER TABLE chat_unread DROP COLUMN last_read_message_id;
@@ -0,0 +1,11 @@
+-- Указатель прочтения: последнее прочитанное сообщение ленты чата.
+-- Непрочитанные считаются от него, отдельный счетчик больше не нужен
+ALTER TABLE chat_unread ADD COLUMN last_read_message_id BIGINT NOT NULL DEFAULT 0;
+
+UPDATE chat_unread cu SET last_read_message_id = COALESCE((
+    SELECT MAX(m.id) FROM messages m
+    WHERE m.chat_id = cu.chat_id AND m.thread_root_id IS NULL AND m.created_at <= cu.last_read_at
+), 0);
+
+DROP INDEX idx_chat_unread_user_nonzero;
+ALTER TABLE chat_unread DROP COLUMN count;
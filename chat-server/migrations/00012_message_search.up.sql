@@ -0,0 +1,5 @@
+-- Полнотекстовый поиск по тексту сообщений. Конфигурация russian, латиница обрабатывается английским стеммером
+ALTER TABLE messages ADD COLUMN text_search tsvector
+    GENERATED ALWAYS AS (to_tsvector('russian', COALESCE(text, ''))) STORED;
+
+CREATE INDEX idx_messages_text_search ON messages USING GIN (text_search);
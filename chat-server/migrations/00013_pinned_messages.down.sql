@@ -0,0 +1 @@
+drop table pinned_messages;
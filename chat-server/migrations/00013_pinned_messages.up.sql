@@ -0,0 +1,11 @@
+-- Закрепленные сообщения чата, закреплять могут владелец и админы
+CREATE TABLE pinned_messages (
+    chat_id BIGINT NOT NULL REFERENCES chats(ID) ON DELETE CASCADE,
+    message_id BIGINT NOT NULL REFERENCES messages(ID) ON DELETE CASCADE,
+    pinned_by BIGINT NOT NULL,
+    pinned_by_username VARCHAR(255) NOT NULL,
+    pinned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (chat_id, message_id)
+);
+
+CREATE INDEX idx_pinned_messages_chat_pinned ON pinned_messages(chat_id, pinned_at DESC);
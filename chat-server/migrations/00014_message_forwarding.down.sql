@@ -0,0 +1,3 @@
+ALTER TABLE messages DROP COLUMN forwarded_from_message_id;
+ALTER TABLE messages DROP COLUMN forwarded_from_chat_id;
+ALTER TABLE messages DROP COLUMN forwarded_from_username;
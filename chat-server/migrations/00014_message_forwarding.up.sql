@@ -0,0 +1,5 @@
+-- Пересланные сообщения хранят источник: автора, чат и исходное сообщение.
+-- При пересылке пересланного сохраняется самый первый источник
+ALTER TABLE messages ADD COLUMN forwarded_from_username VARCHAR(255);
+ALTER TABLE messages ADD COLUMN forwarded_from_chat_id BIGINT;
+ALTER TABLE messages ADD COLUMN forwarded_from_message_id BIGINT REFERENCES messages(ID) ON DELETE SET NULL;
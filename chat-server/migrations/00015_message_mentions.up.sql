@@ -0,0 +1,10 @@
+-- Упоминания участников чата через @username.
+-- Непрочитанные упоминания считаются от указателя прочтения в chat_unread
+CREATE TABLE message_mentions (
+    message_id BIGINT NOT NULL REFERENCES messages(ID) ON DELETE CASCADE,
+    chat_id BIGINT NOT NULL REFERENCES chats(ID) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    PRIMARY KEY (message_id, user_id)
+);
+
+CREATE INDEX idx_message_mentions_user_chat ON message_mentions(user_id, chat_id, message_id);
@@ -0,0 +1,25 @@
+-- Отложенные сообщения, отправляются диспетчером в send_at через обычный путь отправки.
+-- claimed_until - аренда строки репликой на время отправки, после истечения строку может забрать другая реплика
+CREATE TABLE scheduled_messages (
+    ID BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chats(ID) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    from_username VARCHAR(255) NOT NULL,
+    message_type VARCHAR(20) NOT NULL DEFAULT 'text',
+    text TEXT NOT NULL,
+    voice_duration REAL NOT NULL DEFAULT 0,
+    file_url VARCHAR(500) NOT NULL DEFAULT '',
+    file_name VARCHAR(255) NOT NULL DEFAULT '',
+    file_size BIGINT NOT NULL DEFAULT 0,
+    reply_to_id BIGINT,
+    thread_root_id BIGINT,
+    send_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    claimed_until TIMESTAMP,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    failed_at TIMESTAMP
+);
+
+CREATE INDEX idx_scheduled_messages_due ON scheduled_messages(send_at) WHERE failed_at IS NULL;
+CREATE INDEX idx_scheduled_messages_user_chat ON scheduled_messages(user_id, chat_id, send_at);
@@ -0,0 +1,6 @@
+-- Исчезающие сообщения: у чата задается время жизни новых сообщений, у сообщения - момент удаления.
+-- Истекшие сообщения не отдаются сразу, а удаляются фоновой задачей
+ALTER TABLE chats ADD COLUMN message_ttl_seconds INT NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN expires_at TIMESTAMP;
+
+CREATE INDEX idx_messages_expires_at ON messages(expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE poll_votes;
+DROP TABLE poll_options;
+DROP TABLE polls;
@@ -0,0 +1,29 @@
+-- Опросы: сообщение с типом poll, вопрос хранится в тексте сообщения.
+-- Опрос закрыт, если его закрыли вручную или наступило closes_at
+CREATE TABLE polls (
+    message_id BIGINT PRIMARY KEY REFERENCES messages(ID) ON DELETE CASCADE,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+    closes_at TIMESTAMP,
+    closed_at TIMESTAMP
+);
+
+CREATE TABLE poll_options (
+    id BIGSERIAL PRIMARY KEY,
+    message_id BIGINT NOT NULL REFERENCES polls(message_id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    text VARCHAR(100) NOT NULL
+);
+
+CREATE INDEX idx_poll_options_message ON poll_options(message_id, position);
+
+-- Голоса хранятся и в анонимных опросах, чтобы нельзя было проголосовать дважды. Наружу в них отдаются только счетчики
+CREATE TABLE poll_votes (
+    option_id BIGINT NOT NULL REFERENCES poll_options(id) ON DELETE CASCADE,
+    message_id BIGINT NOT NULL REFERENCES polls(message_id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (option_id, user_id)
+);
+
+CREATE INDEX idx_poll_votes_message_user ON poll_votes(message_id, user_id);
@@ -0,0 +1,11 @@
+-- Превью первой ссылки в сообщении: OpenGraph или обычные мета-теги страницы.
+-- Загружается асинхронно после отправки, у сообщений без превью строки нет
+CREATE TABLE link_previews (
+    message_id BIGINT PRIMARY KEY REFERENCES messages(ID) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    title VARCHAR(200) NOT NULL DEFAULT '',
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL DEFAULT '',
+    site_name VARCHAR(200) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
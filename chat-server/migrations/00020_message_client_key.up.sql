@@ -0,0 +1,5 @@
+-- Клиентский ключ идемпотентности: повторная отправка с тем же ключом возвращает уже сохраненное сообщение.
+-- Ключ уникален в пределах автора и чата, у сообщений без ключа ограничения нет
+ALTER TABLE messages ADD COLUMN client_key UUID;
+
+CREATE UNIQUE INDEX idx_messages_client_key ON messages(chat_id, user_id, client_key) WHERE client_key IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX idx_messages_chat_seq;
+ALTER TABLE messages DROP COLUMN seq;
+ALTER TABLE chats DROP COLUMN last_seq;
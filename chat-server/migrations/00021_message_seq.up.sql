@@ -0,0 +1,13 @@
+-- Порядковый номер сообщения в чате: растет без повторов, по пропускам клиент понимает, что нужно догрузить.
+-- Номер выдает счетчик чата тем же запросом, что вставляет сообщение
+ALTER TABLE chats ADD COLUMN last_seq BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN seq BIGINT;
+
+UPDATE messages m SET seq = n.seq
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY chat_id ORDER BY created_at, id) AS seq FROM messages) n
+WHERE m.id = n.id;
+
+UPDATE chats c SET last_seq = COALESCE((SELECT MAX(seq) FROM messages WHERE chat_id = c.id), 0);
+
+ALTER TABLE messages ALTER COLUMN seq SET NOT NULL;
+CREATE UNIQUE INDEX idx_messages_chat_seq ON messages(chat_id, seq);
@@ -0,0 +1 @@
+ALTER TABLE chat_members DROP CONSTRAINT chat_members_role_check;
@@ -0,0 +1,5 @@
+-- Роли участников чата: owner, admin, moderator, member, read_only.
+-- Права ролей описаны в сервисе, в БД только проверяем допустимое значение
+UPDATE chat_members SET role = 'member' WHERE role NOT IN ('owner', 'admin', 'moderator', 'member', 'read_only');
+
+ALTER TABLE chat_members ADD CONSTRAINT chat_members_role_check CHECK (role IN ('owner', 'admin', 'moderator', 'member', 'read_only'));
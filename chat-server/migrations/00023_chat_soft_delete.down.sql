@@ -0,0 +1,3 @@
+DROP INDEX idx_chats_purge_at;
+ALTER TABLE chats DROP COLUMN purge_at;
+ALTER TABLE chats DROP COLUMN deleted_at;
@@ -0,0 +1,6 @@
+-- Мягкое удаление чатов: удаленный чат скрыт от всех, пока не наступит purge_at его можно восстановить.
+-- После purge_at фоновая задача удаляет чат из БД и его файлы из хранилища
+ALTER TABLE chats ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE chats ADD COLUMN purge_at TIMESTAMP;
+
+CREATE INDEX idx_chats_purge_at ON chats(purge_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE chat_invite_uses;
+DROP TABLE chat_invites;
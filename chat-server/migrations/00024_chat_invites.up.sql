@@ -0,0 +1,24 @@
+-- Ссылки-приглашения в групповые чаты. Сам код хранится только в виде sha256,
+-- для списка приглашений остается его начало code_hint. max_uses = 0 - без ограничения
+CREATE TABLE chat_invites (
+    ID BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chats(ID) ON DELETE CASCADE,
+    code_hash BYTEA NOT NULL UNIQUE,
+    code_hint VARCHAR(8) NOT NULL,
+    created_by BIGINT NOT NULL,
+    max_uses INT NOT NULL DEFAULT 0,
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_chat_invites_chat_id ON chat_invites(chat_id, created_at DESC);
+
+-- Кто и когда вступил по приглашению
+CREATE TABLE chat_invite_uses (
+    invite_id BIGINT NOT NULL REFERENCES chat_invites(ID) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (invite_id, user_id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE chat_join_requests;
+ALTER TABLE chats DROP CONSTRAINT chats_join_approval_public;
+ALTER TABLE chats DROP COLUMN join_approval;
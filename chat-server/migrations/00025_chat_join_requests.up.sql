@@ -0,0 +1,18 @@
+-- Третий режим видимости: чат есть в списке открытых, но вступление через заявку, которую одобряет админ.
+-- Имеет смысл только для открытого чата
+ALTER TABLE chats ADD COLUMN join_approval BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE chats ADD CONSTRAINT chats_join_approval_public CHECK (NOT join_approval OR is_public);
+
+-- Заявки на вступление. status: pending, approved, rejected. У пользователя не больше одной ожидающей заявки в чат
+CREATE TABLE chat_join_requests (
+    ID BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chats(ID) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    username VARCHAR(255) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    decided_by BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_chat_join_requests_pending ON chat_join_requests(chat_id, user_id) WHERE status = 'pending';
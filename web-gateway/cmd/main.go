@@ -83,6 +83,7 @@ func main() {
 	r.Static("/static", "./static")
 	r.StaticFile("/", "./static/index.html")
 	r.StaticFile("/chat", "./static/chat.html")
+	r.GET("/invite/:code", handlers.InviteRedirect())
 
 	// API endpoints
 	api := r.Group("/api")
@@ -112,11 +113,44 @@ func main() {
 		api.POST("/chat/create", handlers.CreateChat(chatClient))
 		api.GET("/chat/my", handlers.MyChats(chatClient, authClient))
 		api.POST("/chat/send", handlers.SendMessage(chatClient, notificastionHub))
+		api.POST("/chat/forward", handlers.ForwardMessages(chatClient, notificastionHub))
+		api.POST("/chat/poll", handlers.CreatePoll(chatClient, notificastionHub))
+		api.POST("/chat/scheduled", handlers.ScheduleMessage(chatClient))
+		api.GET("/chat/scheduled", handlers.ListScheduled(chatClient))
+		api.DELETE("/chat/scheduled/:id", handlers.CancelScheduled(chatClient))
+		api.PUT("/chat/message/:id", handlers.EditMessage(chatClient))
+		api.DELETE("/chat/message/:id", handlers.DeleteMessage(chatClient))
+		api.POST("/chat/message/:id/reactions", handlers.AddReaction(chatClient))
+		api.DELETE("/chat/message/:id/reactions", handlers.RemoveReaction(chatClient))
+		api.POST("/chat/message/:id/poll/vote", handlers.VotePoll(chatClient))
+		api.POST("/chat/message/:id/poll/close", handlers.ClosePoll(chatClient))
+		api.GET("/chat/:id/messages", handlers.GetHistory(chatClient))
+		api.GET("/chat/:id/messages/range", handlers.GetMessagesBySeq(chatClient))
+		api.GET("/chat/search", handlers.SearchMessages(chatClient))
+		api.GET("/chat/:id/pins", handlers.ListPinned(chatClient))
+		api.POST("/chat/:id/pins", handlers.PinMessage(chatClient))
+		api.DELETE("/chat/:id/pins/:message_id", handlers.UnpinMessage(chatClient))
+		api.PUT("/chat/:id/ttl", handlers.SetMessageTTL(chatClient))
+		api.GET("/chat/:id/messages/:message_id/receipts", handlers.GetReadReceipts(chatClient))
+		api.GET("/chat/thread/:id", handlers.GetThread(chatClient))
+		api.POST("/chat/thread/read", handlers.MarkThreadRead(chatClient))
 		api.DELETE("/chat/delete/:id", handlers.DeleteChat(chatClient))
+		api.POST("/chat/:id/restore", handlers.RestoreChat(chatClient))
 		api.POST("/chat/direct", handlers.GetOrCreateDirectChat(chatClient))
 		api.POST("/chat/add-member", handlers.AddMember(chatClient))
 		api.POST("/chat/remove-member", handlers.RemoveMember(chatClient))
+		api.PUT("/chat/:id/members/:user_id/role", handlers.SetMemberRole(chatClient))
+		api.POST("/chat/:id/owner", handlers.TransferOwnership(chatClient))
 		api.POST("/chat/join", handlers.JoinChat(chatClient))
+		api.GET("/chat/:id/join-requests", handlers.ListJoinRequests(chatClient))
+		api.POST("/chat/join-requests/:id/approve", handlers.ResolveJoinRequest(chatClient, notificastionHub, true))
+		api.POST("/chat/join-requests/:id/reject", handlers.ResolveJoinRequest(chatClient, notificastionHub, false))
+		api.POST("/chat/leave", handlers.LeaveChat(chatClient))
+		api.POST("/chat/:id/invites", handlers.CreateInvite(chatClient))
+		api.GET("/chat/:id/invites", handlers.ListInvites(chatClient))
+		api.DELETE("/chat/invites/:id", handlers.RevokeInvite(chatClient))
+		api.GET("/invite/:code", handlers.InvitePreview(chatClient))
+		api.POST("/invite/:code/join", handlers.JoinByInvite(chatClient))
 		api.GET("/chat/public", handlers.PublicChats(chatClient))
 		api.POST("/chat/read", handlers.MarkChatRead(chatClient))
 		api.GET("/chat/unread", handlers.UnreadCounts(chatClient))
@@ -129,7 +163,8 @@ func main() {
 	}
 
 	// WebSocket для чата
-	r.GET("/ws/chat/:id", handlers.ConnectChat(chatClient))
+	r.GET("/ws/chat/:id", handlers.ConnectChat(chatClient, notificastionHub))
+	r.GET("/ws/thread/:id", handlers.ConnectThread(chatClient))
 
 	// WebSocket уведомлений
 	r.GET("/ws/notifications", handlers.NotificationsWS(notificastionHub))
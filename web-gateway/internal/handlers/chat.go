@@ -2,10 +2,15 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	user_v1 "github.com/GolZrd/micro-chat/auth/pkg/user_v1"
 	chat_v1 "github.com/GolZrd/micro-chat/chat-server/pkg/chat_v1"
@@ -14,10 +19,12 @@ import (
 	"github.com/GolZrd/micro-chat/web-gateway/internal/logger"
 	"github.com/GolZrd/micro-chat/web-gateway/internal/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var upgrader = websocket.Upgrader{
@@ -26,12 +33,20 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
+// typingThrottle как часто пересылаем в chat-server повторные typing_start, сервер держит индикатор дольше
+const typingThrottle = 2 * time.Second
+
+// wsCloseTimeout сколько ждем отправки close-кадра клиенту, когда стрим закрыл сервер
+const wsCloseTimeout = time.Second
+
 func CreateChat(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req struct {
 			Name      string   `json:"name"`
 			Usernames []string `json:"usernames"`
 			IsPublic  bool     `json:"is_public"`
+
+			JoinApproval bool `json:"join_approval"` // Только для открытого чата: вступление по заявке
 		}
 
 		if err := c.BindJSON(&req); err != nil {
@@ -43,6 +58,7 @@ func CreateChat(client *clients.ChatClient) gin.HandlerFunc {
 		logger.Info("create chat attempt",
 			zap.Strings("usernames", req.Usernames),
 			zap.Bool("is_public", req.IsPublic),
+			zap.Bool("join_approval", req.JoinApproval),
 		)
 
 		// Создаем контекст с токеном из HTTP заголовка
@@ -53,6 +69,8 @@ func CreateChat(client *clients.ChatClient) gin.HandlerFunc {
 			Name:      req.Name,
 			Usernames: req.Usernames,
 			IsPublic:  req.IsPublic,
+
+			JoinApproval: req.JoinApproval,
 		})
 		if err != nil {
 			logger.Error("failed to create chat", zap.Error(err))
@@ -116,6 +134,10 @@ func MyChats(client *clients.ChatClient, authClient *clients.AuthClient) gin.Han
 					memberAvatars[strconv.FormatInt(uid, 10)] = url
 				}
 			}
+			memberRoles := make(map[string]string, len(ch.MemberRoles))
+			for uid, role := range ch.MemberRoles {
+				memberRoles[strconv.FormatInt(uid, 10)] = roleNames[role]
+			}
 			chatData := gin.H{
 				"id":                  ch.Id,
 				"name":                ch.Name,
@@ -129,11 +151,17 @@ func MyChats(client *clients.ChatClient, authClient *clients.AuthClient) gin.Han
 				"last_message":        ch.LastMessage,
 				"last_message_sender": ch.LastMessageSender,
 				"member_avatars":      memberAvatars,
+				"member_roles":        memberRoles,
+				"message_ttl_seconds": ch.MessageTtlSeconds,
+				"join_approval":       ch.JoinApproval,
 			}
 
 			if ch.LastMessageAt != nil {
 				chatData["last_message_at"] = ch.LastMessageAt.AsTime()
 			}
+			if ch.PinnedMessage != nil {
+				chatData["pinned_message"] = convertPreview(ch.PinnedMessage)
+			}
 
 			chats = append(chats, chatData)
 		}
@@ -151,6 +179,9 @@ func SendMessage(client *clients.ChatClient, notificationHub *hub.Hub) gin.Handl
 			Text          string  `json:"text"`
 			Type          int32   `json:"type"`
 			VoiceDuration float32 `json:"voice_duration"`
+			ReplyToId     int64   `json:"reply_to_message_id"`
+			ThreadRootId  int64   `json:"thread_root_id"`
+			ClientKey     string  `json:"client_key"` // UUID для безопасного повтора запроса
 		}
 
 		if err := c.BindJSON(&req); err != nil {
@@ -163,15 +194,18 @@ func SendMessage(client *clients.ChatClient, notificationHub *hub.Hub) gin.Handl
 		ctx := utils.ContextWithToken(c)
 
 		// Вызываем gRPC БЕЗ указания From - chat-server извлечет из токена
-		_, err := client.Client.SendMessage(ctx, &chat_v1.SendMessageRequest{
-			ChatId:        req.ChatId,
-			Text:          req.Text,
-			Type:          chat_v1.MessageType(req.Type),
-			VoiceDuration: req.VoiceDuration,
+		resp, retried, err := sendMessageWithRetry(ctx, client, &chat_v1.SendMessageRequest{
+			ChatId:           req.ChatId,
+			Text:             req.Text,
+			Type:             chat_v1.MessageType(req.Type),
+			VoiceDuration:    req.VoiceDuration,
+			ReplyToMessageId: req.ReplyToId,
+			ThreadRootId:     req.ThreadRootId,
+			ClientKey:        req.ClientKey,
 		})
 		if err != nil {
 			logger.Error("failed to send message", zap.Error(err))
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			handleChatError(c, err)
 			return
 		}
 
@@ -180,95 +214,444 @@ func SendMessage(client *clients.ChatClient, notificationHub *hub.Hub) gin.Handl
 		tokenStr, _ := token.(string)
 		senderClaims, _ := utils.ParseTokenClaims(tokenStr)
 
-		// Рассылаем уведомления в фоне
-		go func() {
-			sendNotifications(client, notificationHub, ctx, senderClaims, req.ChatId, req.Text, req.Type, req.VoiceDuration, "", "", 0)
-		}()
+		// Рассылаем уведомления в фоне. На повторный запрос клиента уведомления уже были разосланы
+		if !resp.Duplicate || retried {
+			go func() {
+				sendNotifications(client, notificationHub, ctx, senderClaims, req.ChatId, req.Text, req.Type, req.VoiceDuration, "", "", 0, resp.MentionedUserIds)
+			}()
+		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "sent"})
+		c.JSON(http.StatusOK, gin.H{"status": "sent", "message_id": resp.MessageId, "seq": resp.Seq, "created_at": resp.CreatedAt.AsTime(), "duplicate": resp.Duplicate})
 	}
 }
 
-func ConnectChat(client *clients.ChatClient) gin.HandlerFunc {
+func CreatePoll(client *clients.ChatClient, notificationHub *hub.Hub) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		var req struct {
+			ChatId         int64      `json:"chat_id"`
+			Question       string     `json:"question"`
+			Options        []string   `json:"options"`
+			MultipleChoice bool       `json:"multiple_choice"`
+			Anonymous      bool       `json:"anonymous"`
+			ClosesAt       *time.Time `json:"closes_at"` // RFC3339, если не задано - опрос закрывается вручную
+			ReplyToId      int64      `json:"reply_to_message_id"`
+			ThreadRootId   int64      `json:"thread_root_id"`
+			ClientKey      string     `json:"client_key"` // UUID для безопасного повтора запроса
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid create poll request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		poll := &chat_v1.PollCreate{
+			Options:        req.Options,
+			MultipleChoice: req.MultipleChoice,
+			Anonymous:      req.Anonymous,
+		}
+		if req.ClosesAt != nil {
+			poll.ClosesAt = timestamppb.New(*req.ClosesAt)
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, retried, err := sendMessageWithRetry(ctx, client, &chat_v1.SendMessageRequest{
+			ChatId:           req.ChatId,
+			Text:             req.Question,
+			Type:             chat_v1.MessageType_MESSAGE_TYPE_POLL,
+			ReplyToMessageId: req.ReplyToId,
+			ThreadRootId:     req.ThreadRootId,
+			Poll:             poll,
+			ClientKey:        req.ClientKey,
+		})
 		if err != nil {
-			logger.Warn("invalid chat id", zap.Error(err))
+			logger.Error("failed to create poll", zap.Int64("chat_id", req.ChatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		token, _ := c.Get("authorization")
+		tokenStr, _ := token.(string)
+		senderClaims, _ := utils.ParseTokenClaims(tokenStr)
+
+		if !resp.Duplicate || retried {
+			go func() {
+				sendNotifications(client, notificationHub, ctx, senderClaims, req.ChatId, req.Question, int32(chat_v1.MessageType_MESSAGE_TYPE_POLL), 0, "", "", 0, resp.MentionedUserIds)
+			}()
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "sent", "message_id": resp.MessageId, "seq": resp.Seq, "created_at": resp.CreatedAt.AsTime(), "duplicate": resp.Duplicate})
+	}
+}
+
+func VotePoll(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		messageId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		var req struct {
+			OptionIds []int64 `json:"option_ids"` // Пустой список отзывает голос
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid vote poll request", zap.Error(err))
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 
-		// Используем Upgrader для установки соединения WebSocket
-		ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.VotePoll(ctx, &chat_v1.VotePollRequest{
+			MessageId: messageId,
+			OptionIds: req.OptionIds,
+		})
 		if err != nil {
-			logger.Error("WebSocket upgrade failed", zap.Error(err))
+			logger.Error("failed to vote poll", zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
 			return
 		}
-		defer ws.Close()
 
-		// Создаем контекст с Отменой через контекст с токеном
-		ctx, cancel := context.WithCancel(utils.ContextWithToken(c))
-		defer cancel() // Отменяем контекст
+		c.JSON(http.StatusOK, gin.H{"poll": convertPoll(resp.Poll)})
+	}
+}
 
-		// Подключаемся к gRPC стриму
-		stream, err := client.Client.ConnectChat(ctx, &chat_v1.ConnectChatRequest{
-			ChatId: chatId,
+func ClosePoll(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		messageId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.ClosePoll(ctx, &chat_v1.ClosePollRequest{MessageId: messageId})
+		if err != nil {
+			logger.Error("failed to close poll", zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "poll closed"})
+	}
+}
+
+func ScheduleMessage(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			ChatId        int64     `json:"chat_id"`
+			Text          string    `json:"text"`
+			Type          int32     `json:"type"`
+			VoiceDuration float32   `json:"voice_duration"`
+			ReplyToId     int64     `json:"reply_to_message_id"`
+			ThreadRootId  int64     `json:"thread_root_id"`
+			SendAt        time.Time `json:"send_at" binding:"required"` // RFC3339
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid schedule message request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.ScheduleMessage(ctx, &chat_v1.ScheduleMessageRequest{
+			Message: &chat_v1.SendMessageRequest{
+				ChatId:           req.ChatId,
+				Text:             req.Text,
+				Type:             chat_v1.MessageType(req.Type),
+				VoiceDuration:    req.VoiceDuration,
+				ReplyToMessageId: req.ReplyToId,
+				ThreadRootId:     req.ThreadRootId,
+			},
+			SendAt: timestamppb.New(req.SendAt),
 		})
 		if err != nil {
-			logger.Error("failed to connect to chat", zap.Error(err))
+			logger.Error("failed to schedule message", zap.Int64("chat_id", req.ChatId), zap.Error(err))
+			handleChatError(c, err)
 			return
 		}
 
-		logger.Info("connected to chat", zap.Int64("chat_id", chatId))
+		c.JSON(http.StatusOK, convertScheduledMessage(resp))
+	}
+}
+
+func ListScheduled(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var chatId int64
+		if v := c.Query("chat_id"); v != "" {
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || id <= 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+				return
+			}
+			chatId = id
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.ListScheduled(ctx, &chat_v1.ListScheduledRequest{ChatId: chatId})
+		if err != nil {
+			logger.Error("failed to list scheduled messages", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		messages := make([]map[string]interface{}, 0, len(resp.Messages))
+		for _, msg := range resp.Messages {
+			messages = append(messages, convertScheduledMessage(msg))
+		}
+
+		c.JSON(http.StatusOK, gin.H{"messages": messages})
+	}
+}
+
+func CancelScheduled(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid scheduled message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.CancelScheduled(ctx, &chat_v1.CancelScheduledRequest{Id: id})
+		if err != nil {
+			logger.Error("failed to cancel scheduled message", zap.Int64("scheduled_id", id), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "canceled"})
+	}
+}
+
+// convertScheduledMessage отложенное сообщение для клиента
+func convertScheduledMessage(msg *chat_v1.ScheduledMessage) map[string]interface{} {
+	res := map[string]interface{}{
+		"id":           msg.Id,
+		"chat_id":      msg.ChatId,
+		"message_type": messageTypeName(msg.Type),
+		"text":         msg.Text,
+		"send_at":      msg.SendAt.AsTime(),
+		"created_at":   msg.CreatedAt.AsTime(),
+		"attempts":     msg.Attempts,
+		"failed":       msg.Failed,
+	}
+	if msg.VoiceDuration > 0 {
+		res["voice_duration"] = msg.VoiceDuration
+	}
+	if msg.FileUrl != "" {
+		res["file_url"] = msg.FileUrl
+		res["file_name"] = msg.FileName
+		res["file_size"] = msg.FileSize
+	}
+	if msg.ReplyToMessageId != 0 {
+		res["reply_to_message_id"] = msg.ReplyToMessageId
+	}
+	if msg.ThreadRootId != 0 {
+		res["thread_root_id"] = msg.ThreadRootId
+	}
+	if msg.LastError != "" {
+		res["last_error"] = msg.LastError
+	}
+
+	return res
+}
+
+func ForwardMessages(client *clients.ChatClient, notificationHub *hub.Hub) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			SourceChatId  int64   `json:"source_chat_id" binding:"required"`
+			MessageIds    []int64 `json:"message_ids" binding:"required"`
+			TargetChatIds []int64 `json:"target_chat_ids" binding:"required"`
+			ClientKey     string  `json:"client_key"` // UUID для безопасного повтора запроса
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid forward messages request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 
-		// Канал для завершения webSocket соединения
-		done := make(chan struct{})
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.ForwardMessages(ctx, &chat_v1.ForwardMessagesRequest{
+			SourceChatId:  req.SourceChatId,
+			MessageIds:    req.MessageIds,
+			TargetChatIds: req.TargetChatIds,
+			ClientKey:     req.ClientKey,
+		})
+		if err != nil {
+			logger.Error("failed to forward messages", zap.Int64("source_chat_id", req.SourceChatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		token, _ := c.Get("authorization")
+		tokenStr, _ := token.(string)
+		senderClaims, _ := utils.ParseTokenClaims(tokenStr)
+
+		messages := make([]map[string]interface{}, 0, len(resp.Messages))
+		for _, m := range resp.Messages {
+			msg := convertToWebSocketMessage(m.Message)
+			msg["chat_id"] = m.ChatId
+			msg["duplicate"] = m.Duplicate
+			messages = append(messages, msg)
+		}
 
-		// Делаем горутину, которая отслеживает закрытие webSocket соединения
+		// Уведомления о каждом пересланном сообщении, как при обычной отправке.
+		// О копиях, созданных прошлой попыткой с тем же client_key, уже уведомляли
 		go func() {
-			defer close(done)
-
-			// ждем закрытия webSocket
-			for {
-				_, _, err := ws.ReadMessage()
-				if err != nil {
-					logger.Debug("Websocket closed", zap.Int64("chat_id", chatId))
-					cancel() // Отменяем контекст
-					return
+			for _, m := range resp.Messages {
+				if m.Duplicate {
+					continue
 				}
+				sendNotifications(client, notificationHub, ctx, senderClaims, m.ChatId, m.Message.Text, int32(m.Message.Type), m.Message.VoiceDuration, m.Message.FileUrl, m.Message.FileName, m.Message.FileSize, m.Message.MentionedUserIds)
 			}
 		}()
 
-		// Читаем сообщения из стрима и отправляем их в WebSocket
-		for {
-			msg, err := stream.Recv()
-			if err != nil {
-				if err == io.EOF || ctx.Err() != nil {
-					break
-				}
-				logger.Error("failed to receive message", zap.Error(err))
-				break
-			}
+		c.JSON(http.StatusOK, gin.H{"messages": messages})
+	}
+}
+
+func EditMessage(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		messageId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
 
-			wsMsg := convertToWebSocketMessage(msg)
+		var req struct {
+			Text string `json:"text"`
+		}
 
-			// Отправляем сообщение в webSocket
-			err = ws.WriteJSON(wsMsg)
-			if err != nil {
-				logger.Error("WebSocket write error", zap.Error(err))
-				break
-			}
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid edit message request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.EditMessage(ctx, &chat_v1.EditMessageRequest{
+			MessageId: messageId,
+			Text:      req.Text,
+		})
+		if err != nil {
+			logger.Error("failed to edit message", zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "message edited"})
+	}
+}
+
+func DeleteMessage(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		messageId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		// По умолчанию удаляем только у себя
+		forEveryone := c.Query("for_everyone") == "true"
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.DeleteMessage(ctx, &chat_v1.DeleteMessageRequest{
+			MessageId:   messageId,
+			ForEveryone: forEveryone,
+		})
+		if err != nil {
+			logger.Error("failed to delete message", zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "message deleted"})
+	}
+}
+
+func AddReaction(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		messageId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		var req struct {
+			Emoji string `json:"emoji"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid add reaction request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.AddReaction(ctx, &chat_v1.ReactionRequest{
+			MessageId: messageId,
+			Emoji:     req.Emoji,
+		})
+		if err != nil {
+			logger.Error("failed to add reaction", zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
 		}
 
-		// Ожидаем завершения webSocket соединения
-		<-done
+		c.JSON(http.StatusOK, gin.H{"status": "reaction added"})
 	}
+}
+
+func RemoveReaction(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		messageId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		// Эмодзи передаем в query, у DELETE запросов тело не принято
+		emoji := c.Query("emoji")
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.RemoveReaction(ctx, &chat_v1.ReactionRequest{
+			MessageId: messageId,
+			Emoji:     emoji,
+		})
+		if err != nil {
+			logger.Error("failed to remove reaction", zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
 
+		c.JSON(http.StatusOK, gin.H{"status": "reaction removed"})
+	}
 }
 
-func DeleteChat(client *clients.ChatClient) gin.HandlerFunc {
+func PinMessage(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Получам ID чата из URL параметра
 		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
 		if err != nil {
 			logger.Warn("invalid chat id", zap.Error(err))
@@ -276,200 +659,1361 @@ func DeleteChat(client *clients.ChatClient) gin.HandlerFunc {
 			return
 		}
 
+		var req struct {
+			MessageId int64 `json:"message_id"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid pin message request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
 		ctx := utils.ContextWithToken(c)
 
-		// Вызываем grpc метод удаления чата
-		_, err = client.Client.Delete(ctx, &chat_v1.DeleteRequest{
-			Id: chatId,
+		_, err = client.Client.PinMessage(ctx, &chat_v1.PinMessageRequest{
+			ChatId:    chatId,
+			MessageId: req.MessageId,
+		})
+		if err != nil {
+			logger.Error("failed to pin message", zap.Int64("chat_id", chatId), zap.Int64("message_id", req.MessageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "message pinned"})
+	}
+}
+
+func SetMessageTTL(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		var req struct {
+			TtlSeconds int32 `json:"ttl_seconds"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid set message ttl request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.SetMessageTTL(ctx, &chat_v1.SetMessageTTLRequest{
+			ChatId:     chatId,
+			TtlSeconds: req.TtlSeconds,
+		})
+		if err != nil {
+			logger.Error("failed to set message ttl", zap.Int64("chat_id", chatId), zap.Int32("ttl_seconds", req.TtlSeconds), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "message ttl updated", "ttl_seconds": req.TtlSeconds})
+	}
+}
+
+func UnpinMessage(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		messageId, err := strconv.ParseInt(c.Param("message_id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.UnpinMessage(ctx, &chat_v1.PinMessageRequest{
+			ChatId:    chatId,
+			MessageId: messageId,
+		})
+		if err != nil {
+			logger.Error("failed to unpin message", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "message unpinned"})
+	}
+}
+
+func ListPinned(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.ListPinned(ctx, &chat_v1.ListPinnedRequest{ChatId: chatId})
+		if err != nil {
+			logger.Error("failed to list pinned messages", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		pins := make([]map[string]interface{}, 0, len(resp.Pins))
+		for _, pin := range resp.Pins {
+			pins = append(pins, map[string]interface{}{
+				"message":            convertToWebSocketMessage(pin.Message),
+				"pinned_by":          pin.PinnedBy,
+				"pinned_by_username": pin.PinnedByUsername,
+				"pinned_at":          pin.PinnedAt.AsTime(),
+			})
+		}
+
+		c.JSON(http.StatusOK, gin.H{"pins": pins})
+	}
+}
+
+func GetHistory(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		// Курсоры и лимит необязательные, around_id - переход к сообщению из поиска
+		beforeId, _ := strconv.ParseInt(c.Query("before_id"), 10, 64)
+		afterId, _ := strconv.ParseInt(c.Query("after_id"), 10, 64)
+		aroundId, _ := strconv.ParseInt(c.Query("around_id"), 10, 64)
+		limit, _ := strconv.Atoi(c.Query("limit"))
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.GetHistory(ctx, &chat_v1.GetHistoryRequest{
+			ChatId:   chatId,
+			BeforeId: beforeId,
+			AfterId:  afterId,
+			AroundId: aroundId,
+			Limit:    int32(limit),
+		})
+		if err != nil {
+			logger.Error("failed to get history", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		// Сообщения в том же формате, что и в WebSocket
+		messages := make([]map[string]interface{}, 0, len(resp.Messages))
+		for _, msg := range resp.Messages {
+			messages = append(messages, convertToWebSocketMessage(msg))
+		}
+
+		res := gin.H{
+			"messages": messages,
+			"has_more": resp.HasMore,
+		}
+		if aroundId > 0 {
+			res["has_newer"] = resp.HasNewer
+		}
+
+		c.JSON(http.StatusOK, res)
+	}
+}
+
+// GetMessagesBySeq догружает пропущенные сообщения чата по диапазону порядковых номеров from_seq..to_seq включительно.
+// Номера общие для ленты и тредов, поэтому в ответе бывают и ответы в тредах
+func GetMessagesBySeq(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		fromSeq, err := strconv.ParseInt(c.Query("from_seq"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from_seq"})
+			return
+		}
+		toSeq, err := strconv.ParseInt(c.Query("to_seq"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to_seq"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.GetMessagesBySeq(ctx, &chat_v1.GetMessagesBySeqRequest{
+			ChatId:  chatId,
+			FromSeq: fromSeq,
+			ToSeq:   toSeq,
+		})
+		if err != nil {
+			logger.Error("failed to get messages by seq", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		messages := make([]map[string]interface{}, 0, len(resp.Messages))
+		for _, msg := range resp.Messages {
+			messages = append(messages, convertToWebSocketMessage(msg))
+		}
+
+		c.JSON(http.StatusOK, gin.H{"messages": messages})
+	}
+}
+
+// SearchMessages полнотекстовый поиск по сообщениям в чатах пользователя.
+// Параметры: q, chat_id, from, type (text, voice, image, file), date_from, date_to (RFC3339 или 2006-01-02), before_id, limit
+func SearchMessages(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &chat_v1.SearchMessagesRequest{
+			Query:        c.Query("q"),
+			FromUsername: c.Query("from"),
+		}
+
+		req.ChatId, _ = strconv.ParseInt(c.Query("chat_id"), 10, 64)
+		req.BeforeId, _ = strconv.ParseInt(c.Query("before_id"), 10, 64)
+		limit, _ := strconv.Atoi(c.Query("limit"))
+		req.Limit = int32(limit)
+
+		if typeName := c.Query("type"); typeName != "" {
+			msgType, ok := searchMessageTypes[typeName]
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid type"})
+				return
+			}
+			req.Type = &msgType
+		}
+
+		for param, dest := range map[string]**timestamppb.Timestamp{"date_from": &req.DateFrom, "date_to": &req.DateTo} {
+			value := c.Query(param)
+			if value == "" {
+				continue
+			}
+
+			t, err := parseSearchDate(value, param == "date_to")
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + param})
+				return
+			}
+			*dest = timestamppb.New(t)
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.SearchMessages(ctx, req)
+		if err != nil {
+			logger.Error("failed to search messages", zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		results := make([]map[string]interface{}, 0, len(resp.Results))
+		for _, r := range resp.Results {
+			// Куда перейти: ответ в треде открываем через его корень в ленте
+			jump := gin.H{
+				"chat_id":    r.ChatId,
+				"message_id": r.Message.Id,
+			}
+			if r.Message.ThreadRootId > 0 {
+				jump["message_id"] = r.Message.ThreadRootId
+				jump["thread_root_id"] = r.Message.ThreadRootId
+			}
+
+			results = append(results, map[string]interface{}{
+				"chat_id":   r.ChatId,
+				"chat_name": r.ChatName,
+				"snippet":   r.Snippet,
+				"message":   convertToWebSocketMessage(r.Message),
+				"jump":      jump,
+			})
+		}
+
+		res := gin.H{
+			"results":  results,
+			"has_more": resp.HasMore,
+		}
+		if resp.HasMore && len(resp.Results) > 0 {
+			res["next_before_id"] = resp.Results[len(resp.Results)-1].Message.Id
+		}
+
+		c.JSON(http.StatusOK, res)
+	}
+}
+
+// searchMessageTypes типы сообщений, по которым можно фильтровать поиск
+var searchMessageTypes = map[string]chat_v1.MessageType{
+	"text":  chat_v1.MessageType_MESSAGE_TYPE_TEXT,
+	"voice": chat_v1.MessageType_MESSAGE_TYPE_VOICE,
+	"image": chat_v1.MessageType_MESSAGE_TYPE_IMAGE,
+	"file":  chat_v1.MessageType_MESSAGE_TYPE_FILE,
+}
+
+// parseSearchDate разбирает дату фильтра поиска. Для даты без времени конец диапазона включает весь день
+func parseSearchDate(value string, isEnd bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if isEnd {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, nil
+}
+
+func GetThread(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rootId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid thread root id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid thread_root_id"})
+			return
+		}
+
+		beforeId, _ := strconv.ParseInt(c.Query("before_id"), 10, 64)
+		afterId, _ := strconv.ParseInt(c.Query("after_id"), 10, 64)
+		limit, _ := strconv.Atoi(c.Query("limit"))
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.GetThread(ctx, &chat_v1.GetThreadRequest{
+			ThreadRootId: rootId,
+			BeforeId:     beforeId,
+			AfterId:      afterId,
+			Limit:        int32(limit),
+		})
+		if err != nil {
+			logger.Error("failed to get thread", zap.Int64("thread_root_id", rootId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		replies := make([]map[string]interface{}, 0, len(resp.Replies))
+		for _, msg := range resp.Replies {
+			replies = append(replies, convertToWebSocketMessage(msg))
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"root":     convertToWebSocketMessage(resp.Root),
+			"replies":  replies,
+			"has_more": resp.HasMore,
+		})
+	}
+}
+
+func MarkThreadRead(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			ThreadRootId int64 `json:"thread_root_id"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid mark thread read request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err := client.Client.MarkThreadRead(ctx, &chat_v1.MarkThreadReadRequest{ThreadRootId: req.ThreadRootId})
+		if err != nil {
+			logger.Error("failed to mark thread read", zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "thread marked as read successfully"})
+	}
+}
+
+// ConnectChat связывает WebSocket с двунаправленным стримом ChatStream: по одному соединению приходят сообщения чата,
+// а клиент отправляет свои сообщения и события "печатает" и получает подтверждения по своему correlation_id
+func ConnectChat(client *clients.ChatClient, notificationHub *hub.Hub) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Используем Upgrader для установки соединения WebSocket
+		ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			logger.Error("WebSocket upgrade failed", zap.Error(err))
+			return
+		}
+		defer ws.Close()
+
+		// Создаем контекст с Отменой через контекст с токеном
+		ctx, cancel := context.WithCancel(utils.ContextWithToken(c))
+		defer cancel() // Отменяем контекст
+
+		// Подключаемся к gRPC стриму
+		stream, err := client.Client.ChatStream(ctx)
+		if err != nil {
+			logger.Error("failed to connect to chat", zap.Error(err))
+			return
+		}
+
+		// Первым кадром сообщаем, к какому чату подключаемся
+		err = stream.Send(&chat_v1.ChatClientFrame{
+			Frame: &chat_v1.ChatClientFrame_Join{Join: &chat_v1.ConnectChatRequest{ChatId: chatId}},
+		})
+		if err != nil {
+			logger.Error("failed to join chat", zap.Int64("chat_id", chatId), zap.Error(err))
+			return
+		}
+
+		logger.Info("connected to chat", zap.Int64("chat_id", chatId))
+
+		// Получаем данные отправителя из токена для уведомлений
+		token, _ := c.Get("authorization")
+		tokenStr, _ := token.(string)
+		senderClaims, _ := utils.ParseTokenClaims(tokenStr)
+
+		pending := newPendingSends()
+
+		recv := func() (map[string]interface{}, error) {
+			frame, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+
+			if ack := frame.GetAck(); ack != nil {
+				send, ok := pending.take(ack.CorrelationId)
+				if !ok {
+					return nil, nil
+				}
+
+				wsAck := map[string]interface{}{
+					"type":           "ack",
+					"correlation_id": send.correlationId,
+					"ok":             ack.Code == 0,
+				}
+				if ack.Code != 0 {
+					wsAck["error"] = ack.Error
+					return wsAck, nil
+				}
+				wsAck["message_id"] = ack.MessageId
+				wsAck["duplicate"] = ack.Duplicate
+				wsAck["seq"] = ack.Seq
+				wsAck["created_at"] = ack.CreatedAt.AsTime()
+
+				// Рассылаем уведомления в фоне так же, как при отправке через REST. На повтор уведомления уже были разосланы
+				if !ack.Duplicate {
+					go func() {
+						sendNotifications(client, notificationHub, utils.ContextWithToken(c), senderClaims, chatId, send.text, send.msgType, send.voiceDuration, "", "", 0, ack.MentionedUserIds)
+					}()
+				}
+
+				return wsAck, nil
+			}
+
+			return convertToWebSocketMessage(frame.GetMessage()), nil
+		}
+
+		forwardStreamToWebSocket(ctx, cancel, ws, recv, chatFrameHandler(stream, chatId, pending), zap.Int64("chat_id", chatId))
+	}
+
+}
+
+func ConnectThread(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rootId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid thread root id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			logger.Error("WebSocket upgrade failed", zap.Error(err))
+			return
+		}
+		defer ws.Close()
+
+		ctx, cancel := context.WithCancel(utils.ContextWithToken(c))
+		defer cancel()
+
+		stream, err := client.Client.ConnectThread(ctx, &chat_v1.ConnectThreadRequest{
+			ThreadRootId: rootId,
+		})
+		if err != nil {
+			logger.Error("failed to connect to thread", zap.Error(err))
+			return
+		}
+
+		logger.Info("connected to thread", zap.Int64("thread_root_id", rootId))
+
+		recv := func() (map[string]interface{}, error) {
+			msg, err := stream.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return convertToWebSocketMessage(msg), nil
+		}
+
+		forwardStreamToWebSocket(ctx, cancel, ws, recv, nil, zap.Int64("thread_root_id", rootId))
+	}
+}
+
+// pendingSend сообщение, отправленное через WebSocket и ожидающее подтверждения от chat-server
+type pendingSend struct {
+	correlationId string
+	text          string
+	msgType       int32
+	voiceDuration float32
+}
+
+// pendingSends сопоставляет подтверждения chat-server с кадрами клиента.
+// В chat-server уходит собственный порядковый номер, поэтому correlation_id клиента может быть любым
+type pendingSends struct {
+	mu    sync.Mutex
+	seq   int64
+	sends map[string]pendingSend
+}
+
+func newPendingSends() *pendingSends {
+	return &pendingSends{sends: make(map[string]pendingSend)}
+}
+
+// add запоминает отправку и возвращает correlation_id для chat-server
+func (p *pendingSends) add(send pendingSend) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.seq++
+	id := strconv.FormatInt(p.seq, 10)
+	p.sends[id] = send
+
+	return id
+}
+
+// take забирает отправку по correlation_id chat-server
+func (p *pendingSends) take(id string) (pendingSend, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	send, ok := p.sends[id]
+	delete(p.sends, id)
+
+	return send, ok
+}
+
+// maxStreamClientKeys сколько ключей идемпотентности, выданных по correlation_id, помнит одно соединение
+const maxStreamClientKeys = 512
+
+// streamClientKeys выдает ключ идемпотентности для кадров без client_key: повторный кадр с тем же
+// correlation_id в том же соединении получает тот же ключ, и chat-server не создаст второе сообщение
+type streamClientKeys struct {
+	keys  map[string]string
+	order []string
+}
+
+func (k *streamClientKeys) get(correlationId string) string {
+	if correlationId == "" {
+		return uuid.NewString()
+	}
+	if key, ok := k.keys[correlationId]; ok {
+		return key
+	}
+
+	if len(k.order) >= maxStreamClientKeys {
+		delete(k.keys, k.order[0])
+		k.order = k.order[1:]
+	}
+
+	key := uuid.NewString()
+	k.keys[correlationId] = key
+	k.order = append(k.order, correlationId)
+
+	return key
+}
+
+// chatFrameHandler обрабатывает кадры клиента и пересылает их в ChatStream:
+// {"type": "message", "correlation_id": "...", "client_key": "...", "text": "...", ...}, {"type": "typing_start"} и {"type": "typing_stop"}.
+// Вызывается только из читающей горутины, поэтому stream.Send и состояние без блокировок
+func chatFrameHandler(stream chat_v1.Chat_ChatStreamClient, chatId int64, pending *pendingSends) func(data []byte) {
+	var lastStart time.Time
+	clientKeys := &streamClientKeys{keys: make(map[string]string)}
+
+	return func(data []byte) {
+		var frame struct {
+			Type          string  `json:"type"`
+			CorrelationId string  `json:"correlation_id"`
+			ClientKey     string  `json:"client_key"` // UUID для безопасного повтора кадра, как в REST
+			Text          string  `json:"text"`
+			MessageType   int32   `json:"message_type"`
+			VoiceDuration float32 `json:"voice_duration"`
+			ReplyToId     int64   `json:"reply_to_message_id"`
+			ThreadRootId  int64   `json:"thread_root_id"`
+		}
+		if err := json.Unmarshal(data, &frame); err != nil {
+			logger.Debug("invalid websocket frame", zap.Int64("chat_id", chatId), zap.Error(err))
+			return
+		}
+
+		var out *chat_v1.ChatClientFrame
+		switch frame.Type {
+		case "message":
+			if !isClientMessageType(frame.MessageType) {
+				logger.Debug("websocket frame with service message type", zap.Int64("chat_id", chatId), zap.Int32("message_type", frame.MessageType))
+				return
+			}
+			if frame.ClientKey == "" {
+				frame.ClientKey = clientKeys.get(frame.CorrelationId)
+			}
+
+			correlationId := pending.add(pendingSend{
+				correlationId: frame.CorrelationId,
+				text:          frame.Text,
+				msgType:       frame.MessageType,
+				voiceDuration: frame.VoiceDuration,
+			})
+
+			out = &chat_v1.ChatClientFrame{
+				CorrelationId: correlationId,
+				Frame: &chat_v1.ChatClientFrame_Send{Send: &chat_v1.SendMessageRequest{
+					ChatId:           chatId,
+					Text:             frame.Text,
+					Type:             chat_v1.MessageType(frame.MessageType),
+					VoiceDuration:    frame.VoiceDuration,
+					ReplyToMessageId: frame.ReplyToId,
+					ThreadRootId:     frame.ThreadRootId,
+					ClientKey:        frame.ClientKey,
+				}},
+			}
+		case "typing_start", "typing_stop":
+			isTyping := frame.Type == "typing_start"
+			if isTyping {
+				if time.Since(lastStart) < typingThrottle {
+					return
+				}
+				lastStart = time.Now()
+			} else {
+				lastStart = time.Time{}
+			}
+
+			out = &chat_v1.ChatClientFrame{
+				Frame: &chat_v1.ChatClientFrame_Typing{Typing: &chat_v1.SetTypingRequest{
+					ChatId:   chatId,
+					IsTyping: isTyping,
+				}},
+			}
+		default:
+			return
+		}
+
+		if err := stream.Send(out); err != nil {
+			logger.Warn("failed to forward websocket frame", zap.Int64("chat_id", chatId), zap.String("type", frame.Type), zap.Error(err))
+		}
+	}
+}
+
+// isClientMessageType проверяет, что клиент может отправить сообщение такого типа.
+// Остальные типы - служебные события, их рассылает только сервер
+func isClientMessageType(msgType int32) bool {
+	switch chat_v1.MessageType(msgType) {
+	case chat_v1.MessageType_MESSAGE_TYPE_TEXT,
+		chat_v1.MessageType_MESSAGE_TYPE_VOICE,
+		chat_v1.MessageType_MESSAGE_TYPE_IMAGE,
+		chat_v1.MessageType_MESSAGE_TYPE_FILE,
+		chat_v1.MessageType_MESSAGE_TYPE_POLL:
+		return true
+	}
+	return false
+}
+
+// forwardStreamToWebSocket пересылает сообщения из gRPC стрима в WebSocket, пока одна из сторон не закроется.
+// recv возвращает уже готовый кадр для WebSocket, nil пропускается. Кадры от клиента передаются в onFrame, если он задан
+func forwardStreamToWebSocket(ctx context.Context, cancel context.CancelFunc, ws *websocket.Conn, recv func() (map[string]interface{}, error), onFrame func(data []byte), field zap.Field) {
+	// Канал для завершения webSocket соединения
+	done := make(chan struct{})
+
+	// Делаем горутину, которая отслеживает закрытие webSocket соединения
+	go func() {
+		defer close(done)
+
+		// читаем кадры клиента до закрытия webSocket
+		for {
+			_, data, err := ws.ReadMessage()
+			if err != nil {
+				logger.Debug("Websocket closed", field)
+				cancel() // Отменяем контекст
+				return
+			}
+
+			if onFrame != nil {
+				onFrame(data)
+			}
+		}
+	}()
+
+	// Читаем сообщения из стрима и отправляем их в WebSocket
+	closeCode := websocket.CloseNormalClosure
+	for {
+		wsMsg, err := recv()
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				break
+			}
+			logger.Error("failed to receive message", field, zap.Error(err))
+			closeCode = websocket.CloseInternalServerErr
+			break
+		}
+		if wsMsg == nil {
+			continue
+		}
+
+		// Отправляем сообщение в webSocket
+		err = ws.WriteJSON(wsMsg)
+		if err != nil {
+			logger.Error("WebSocket write error", field, zap.Error(err))
+			break
+		}
+	}
+
+	// Стрим закончился раньше клиента (например, чат удалили или пользователь вышел из него).
+	// Закрываем webSocket сами, иначе читающая горутина так и ждала бы клиента
+	if ctx.Err() == nil {
+		msg := websocket.FormatCloseMessage(closeCode, "")
+		if err := ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsCloseTimeout)); err != nil {
+			logger.Debug("failed to send websocket close frame", field, zap.Error(err))
+		}
+	}
+	ws.Close()
+
+	// Ожидаем завершения webSocket соединения
+	<-done
+}
+
+func DeleteChat(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Получам ID чата из URL параметра
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		// Вызываем grpc метод удаления чата
+		resp, err := client.Client.Delete(ctx, &chat_v1.DeleteRequest{
+			Id: chatId,
 		})
 		if err != nil {
 			logger.Error("Failed to delete chat", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		logger.Info("Chat deleted successfully", zap.Int64("chat_id", chatId))
+
+		res := gin.H{"status": "chat deleted successfully"}
+		if resp.RestoreUntil != nil {
+			res["restore_until"] = resp.RestoreUntil.AsTime()
+		}
+
+		c.JSON(http.StatusOK, res)
+	}
+}
+
+func RestoreChat(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.RestoreChat(ctx, &chat_v1.RestoreChatRequest{
+			ChatId: chatId,
+		})
+		if err != nil {
+			logger.Error("failed to restore chat", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		logger.Info("chat restored", zap.Int64("chat_id", chatId))
+
+		c.JSON(http.StatusOK, gin.H{"status": "chat restored"})
+	}
+}
+
+func GetOrCreateDirectChat(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			UserId   int64  `json:"user_id"`
+			Username string `json:"username"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid get or create direct chat request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.GetOrCreateDirectChat(ctx, &chat_v1.GetOrCreateDirectChatRequest{
+			UserId:   req.UserId,
+			Username: req.Username,
+		})
+		if err != nil {
+			logger.Error("failed to get or create direct chat", zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"chat_id": resp.ChatId, "created": resp.Created})
+	}
+}
+
+func Heartbeat(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := utils.ContextWithToken(c)
+
+		_, err := client.Client.Heartbeat(ctx, &chat_v1.HeartbeatRequest{})
+		if err != nil {
+			logger.Error("failed to heartbeat", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
+
+func FriendsPresence(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			UserIds []int64 `json:"user_ids"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid friends presence request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if len(req.UserIds) == 0 {
+			c.JSON(http.StatusOK, gin.H{"presences": []interface{}{}})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.FriendsPresence(ctx, &chat_v1.FriendsPresenceRequest{
+			UserIds: req.UserIds,
+		})
+		if err != nil {
+			logger.Error("failed to get friends presence", zap.Error(err))
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
-		logger.Info("Chat deleted successfully", zap.Int64("chat_id", chatId))
+		presences := make([]gin.H, 0, len(resp.Friends))
+		for _, f := range resp.Friends {
+			presence := gin.H{
+				"user_id":   f.UserId,
+				"is_online": f.IsOnline,
+			}
+
+			// Поле last seen добавляем только если друг не онлайн
+			if !f.IsOnline && f.LastSeenAt != nil {
+				presence["last_seen_at"] = f.LastSeenAt.AsTime()
+			}
+
+			presences = append(presences, presence)
+		}
+
+		c.JSON(http.StatusOK, gin.H{"presences": presences})
+	}
+}
+
+func AddMember(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			ChatId   int64  `json:"chat_id"`
+			Username string `json:"username"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid add member request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err := client.Client.AddMember(ctx, &chat_v1.AddMemberRequest{
+			ChatId:   req.ChatId,
+			Username: req.Username,
+		})
+		if err != nil {
+			logger.Error("failed to add member", zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "member added successfully"})
+	}
+}
+
+func RemoveMember(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			ChatId   int64  `json:"chat_id"`
+			UserId   int64  `json:"user_id"`
+			Username string `json:"username"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid remove member request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err := client.Client.RemoveMember(ctx, &chat_v1.RemoveMemberRequest{
+			ChatId:   req.ChatId,
+			UserId:   req.UserId,
+			Username: req.Username,
+		})
+		if err != nil {
+			logger.Error("failed to remove member", zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "member removed successfully"})
+	}
+}
+
+// roleNames названия ролей участников в JSON API
+var roleNames = map[chat_v1.MemberRole]string{
+	chat_v1.MemberRole_MEMBER_ROLE_OWNER:     "owner",
+	chat_v1.MemberRole_MEMBER_ROLE_ADMIN:     "admin",
+	chat_v1.MemberRole_MEMBER_ROLE_MODERATOR: "moderator",
+	chat_v1.MemberRole_MEMBER_ROLE_MEMBER:    "member",
+	chat_v1.MemberRole_MEMBER_ROLE_READ_ONLY: "read_only",
+}
+
+func SetMemberRole(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid user id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user_id"})
+			return
+		}
+
+		var req struct {
+			Role string `json:"role"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid set member role request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var role chat_v1.MemberRole
+		for r, name := range roleNames {
+			if name == req.Role {
+				role = r
+			}
+		}
+		if role == chat_v1.MemberRole_MEMBER_ROLE_UNSPECIFIED {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "unknown role"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.SetMemberRole(ctx, &chat_v1.SetMemberRoleRequest{
+			ChatId: chatId,
+			UserId: userId,
+			Role:   role,
+		})
+		if err != nil {
+			logger.Error("failed to set member role", zap.Int64("chat_id", chatId), zap.Int64("user_id", userId), zap.String("role", req.Role), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "member role updated", "role": req.Role})
+	}
+}
+
+func TransferOwnership(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		var req struct {
+			UserId int64 `json:"user_id"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid transfer ownership request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		_, err = client.Client.TransferOwnership(ctx, &chat_v1.TransferOwnershipRequest{
+			ChatId: chatId,
+			UserId: req.UserId,
+		})
+		if err != nil {
+			logger.Error("failed to transfer ownership", zap.Int64("chat_id", chatId), zap.Int64("user_id", req.UserId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "ownership transferred"})
+	}
+}
+
+func JoinChat(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			ChatId int64 `json:"chat_id"`
+		}
+
+		if err := c.BindJSON(&req); err != nil {
+			logger.Debug("invalid join chat request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.JoinChat(ctx, &chat_v1.JoinChatRequest{
+			ChatId: req.ChatId,
+		})
+		if err != nil {
+			logger.Error("failed to join chat", zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		if resp.Pending {
+			c.JSON(http.StatusAccepted, gin.H{"status": "join request sent", "pending": true})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"status": "joined successfully", "pending": false})
+	}
+}
+
+func ListJoinRequests(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.ListJoinRequests(ctx, &chat_v1.ListJoinRequestsRequest{ChatId: chatId})
+		if err != nil {
+			logger.Error("failed to list join requests", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		requests := make([]gin.H, 0, len(resp.Requests))
+		for _, r := range resp.Requests {
+			requests = append(requests, gin.H{
+				"id":         r.Id,
+				"chat_id":    r.ChatId,
+				"user_id":    r.UserId,
+				"username":   r.Username,
+				"created_at": r.CreatedAt.AsTime(),
+			})
+		}
+
+		c.JSON(http.StatusOK, gin.H{"requests": requests})
+	}
+}
+
+// ResolveJoinRequest одобряет или отклоняет заявку на вступление и уведомляет заявителя о решении
+func ResolveJoinRequest(client *clients.ChatClient, notificationHub *hub.Hub, approve bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid join request id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		req := &chat_v1.ResolveJoinRequestRequest{RequestId: requestId}
+
+		var resp *chat_v1.JoinRequestDecision
+		if approve {
+			resp, err = client.Client.ApproveJoinRequest(ctx, req)
+		} else {
+			resp, err = client.Client.RejectJoinRequest(ctx, req)
+		}
+		if err != nil {
+			logger.Error("failed to resolve join request", zap.Int64("request_id", requestId), zap.Bool("approve", approve), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		notification := hub.Notification{
+			Type:     "join_request_rejected",
+			ChatId:   resp.ChatId,
+			ChatName: resp.ChatName,
+			Text:     "Заявка на вступление отклонена",
+		}
+		if resp.Approved {
+			notification.Type = "join_request_approved"
+			notification.Text = "Заявка на вступление одобрена"
+		}
+		notificationHub.NotifyUser(resp.UserId, notification)
 
-		c.JSON(http.StatusOK, gin.H{"status": "chat deleted successfully"})
+		logger.Info("join request resolved", zap.Int64("request_id", requestId), zap.Int64("chat_id", resp.ChatId), zap.Bool("approved", resp.Approved))
+
+		c.JSON(http.StatusOK, gin.H{
+			"request_id": resp.RequestId,
+			"chat_id":    resp.ChatId,
+			"user_id":    resp.UserId,
+			"approved":   resp.Approved,
+		})
 	}
 }
 
-func GetOrCreateDirectChat(client *clients.ChatClient) gin.HandlerFunc {
+func LeaveChat(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req struct {
-			UserId   int64  `json:"user_id"`
-			Username string `json:"username"`
+			ChatId int64 `json:"chat_id"`
 		}
 
 		if err := c.BindJSON(&req); err != nil {
-			logger.Debug("invalid get or create direct chat request", zap.Error(err))
+			logger.Debug("invalid leave chat request", zap.Error(err))
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 
 		ctx := utils.ContextWithToken(c)
 
-		resp, err := client.Client.GetOrCreateDirectChat(ctx, &chat_v1.GetOrCreateDirectChatRequest{
-			UserId:   req.UserId,
-			Username: req.Username,
+		_, err := client.Client.LeaveChat(ctx, &chat_v1.LeaveChatRequest{
+			ChatId: req.ChatId,
 		})
 		if err != nil {
-			logger.Error("failed to get or create direct chat", zap.Error(err))
+			logger.Error("failed to leave chat", zap.Int64("chat_id", req.ChatId), zap.Error(err))
 			handleChatError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"chat_id": resp.ChatId, "created": resp.Created})
+		c.JSON(http.StatusOK, gin.H{"status": "left chat successfully"})
 	}
 }
 
-func Heartbeat(client *clients.ChatClient) gin.HandlerFunc {
+func CreateInvite(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		ctx := utils.ContextWithToken(c)
-
-		_, err := client.Client.Heartbeat(ctx, &chat_v1.HeartbeatRequest{})
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
 		if err != nil {
-			logger.Error("failed to heartbeat", zap.Error(err))
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "ok"})
-	}
-}
-
-func FriendsPresence(client *clients.ChatClient) gin.HandlerFunc {
-	return func(c *gin.Context) {
 		var req struct {
-			UserIds []int64 `json:"user_ids"`
+			MaxUses   int32      `json:"max_uses"`   // 0 - без ограничения
+			ExpiresAt *time.Time `json:"expires_at"` // RFC3339, без срока если не задано
 		}
 
-		if err := c.BindJSON(&req); err != nil {
-			logger.Debug("invalid friends presence request", zap.Error(err))
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			logger.Debug("invalid create invite request", zap.Error(err))
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 
-		if len(req.UserIds) == 0 {
-			c.JSON(http.StatusOK, gin.H{"presences": []interface{}{}})
-			return
+		grpcReq := &chat_v1.CreateInviteRequest{
+			ChatId:  chatId,
+			MaxUses: req.MaxUses,
+		}
+		if req.ExpiresAt != nil {
+			grpcReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
 		}
 
 		ctx := utils.ContextWithToken(c)
 
-		resp, err := client.Client.FriendsPresence(ctx, &chat_v1.FriendsPresenceRequest{
-			UserIds: req.UserIds,
-		})
+		resp, err := client.Client.CreateInvite(ctx, grpcReq)
 		if err != nil {
-			logger.Error("failed to get friends presence", zap.Error(err))
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			logger.Error("failed to create invite", zap.Int64("chat_id", chatId), zap.Error(err))
+			handleChatError(c, err)
 			return
 		}
 
-		presences := make([]gin.H, 0, len(resp.Friends))
-		for _, f := range resp.Friends {
-			presence := gin.H{
-				"user_id":   f.UserId,
-				"is_online": f.IsOnline,
-			}
-
-			// Поле last seen добавляем только если друг не онлайн
-			if !f.IsOnline && f.LastSeenAt != nil {
-				presence["last_seen_at"] = f.LastSeenAt.AsTime()
-			}
-
-			presences = append(presences, presence)
-		}
+		res := convertInvite(resp)
+		res["code"] = resp.Code
+		res["invite_url"] = "/invite/" + resp.Code
 
-		c.JSON(http.StatusOK, gin.H{"presences": presences})
+		c.JSON(http.StatusOK, res)
 	}
 }
 
-func AddMember(client *clients.ChatClient) gin.HandlerFunc {
+func ListInvites(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req struct {
-			ChatId   int64  `json:"chat_id"`
-			Username string `json:"username"`
-		}
-
-		if err := c.BindJSON(&req); err != nil {
-			logger.Debug("invalid add member request", zap.Error(err))
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
 			return
 		}
 
 		ctx := utils.ContextWithToken(c)
 
-		_, err := client.Client.AddMember(ctx, &chat_v1.AddMemberRequest{
-			ChatId:   req.ChatId,
-			Username: req.Username,
-		})
+		resp, err := client.Client.ListInvites(ctx, &chat_v1.ListInvitesRequest{ChatId: chatId})
 		if err != nil {
-			logger.Error("failed to add member", zap.Error(err))
+			logger.Error("failed to list invites", zap.Int64("chat_id", chatId), zap.Error(err))
 			handleChatError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "member added successfully"})
+		invites := make([]gin.H, 0, len(resp.Invites))
+		for _, invite := range resp.Invites {
+			invites = append(invites, convertInvite(invite))
+		}
+
+		c.JSON(http.StatusOK, gin.H{"invites": invites})
 	}
 }
 
-func RemoveMember(client *clients.ChatClient) gin.HandlerFunc {
+func RevokeInvite(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req struct {
-			ChatId   int64  `json:"chat_id"`
-			UserId   int64  `json:"user_id"`
-			Username string `json:"username"`
-		}
-
-		if err := c.BindJSON(&req); err != nil {
-			logger.Debug("invalid remove member request", zap.Error(err))
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		inviteId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid invite id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid invite id"})
 			return
 		}
 
 		ctx := utils.ContextWithToken(c)
 
-		_, err := client.Client.RemoveMember(ctx, &chat_v1.RemoveMemberRequest{
-			ChatId:   req.ChatId,
-			UserId:   req.UserId,
-			Username: req.Username,
-		})
+		_, err = client.Client.RevokeInvite(ctx, &chat_v1.RevokeInviteRequest{InviteId: inviteId})
 		if err != nil {
-			logger.Error("failed to remove member", zap.Error(err))
+			logger.Error("failed to revoke invite", zap.Int64("invite_id", inviteId), zap.Error(err))
 			handleChatError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "member removed successfully"})
+		c.JSON(http.StatusOK, gin.H{"status": "invite revoked"})
 	}
 }
 
-func JoinChat(client *clients.ChatClient) gin.HandlerFunc {
+func InvitePreview(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var req struct {
-			ChatId int64 `json:"chat_id"`
-		}
+		ctx := utils.ContextWithToken(c)
 
-		if err := c.BindJSON(&req); err != nil {
-			logger.Debug("invalid join chat request", zap.Error(err))
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		resp, err := client.Client.GetInvitePreview(ctx, &chat_v1.GetInvitePreviewRequest{Code: c.Param("code")})
+		if err != nil {
+			logger.Warn("failed to get invite preview", zap.Error(err))
+			handleChatError(c, err)
 			return
 		}
 
+		c.JSON(http.StatusOK, gin.H{
+			"chat_id":      resp.ChatId,
+			"name":         resp.Name,
+			"member_count": resp.MemberCount,
+			"is_member":    resp.IsMember,
+		})
+	}
+}
+
+func JoinByInvite(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
 		ctx := utils.ContextWithToken(c)
 
-		_, err := client.Client.JoinChat(ctx, &chat_v1.JoinChatRequest{
-			ChatId: req.ChatId,
-		})
+		resp, err := client.Client.JoinByInvite(ctx, &chat_v1.JoinByInviteRequest{Code: c.Param("code")})
 		if err != nil {
-			logger.Error("failed to join chat", zap.Error(err))
+			logger.Warn("failed to join by invite", zap.Error(err))
 			handleChatError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"status": "joined successfully"})
+		c.JSON(http.StatusOK, gin.H{
+			"chat_id":        resp.ChatId,
+			"already_member": resp.AlreadyMember,
+		})
+	}
+}
+
+// InviteRedirect открывает ссылку-приглашение в приложении. Токен в браузерном переходе не передается,
+// поэтому превью и вступление выполняет фронтенд
+func InviteRedirect() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Redirect(http.StatusFound, "/?invite="+url.QueryEscape(c.Param("code")))
+	}
+}
+
+// convertInvite приглашение без самого кода, он известен только при создании
+func convertInvite(invite *chat_v1.Invite) gin.H {
+	res := gin.H{
+		"id":         invite.Id,
+		"chat_id":    invite.ChatId,
+		"code_hint":  invite.CodeHint,
+		"created_by": invite.CreatedBy,
+		"max_uses":   invite.MaxUses,
+		"uses":       invite.Uses,
+		"created_at": invite.CreatedAt.AsTime(),
+		"revoked":    invite.Revoked,
 	}
+	if invite.ExpiresAt != nil {
+		res["expires_at"] = invite.ExpiresAt.AsTime()
+	}
+
+	return res
 }
 
 func PublicChats(client *clients.ChatClient) gin.HandlerFunc {
@@ -499,6 +2043,8 @@ func PublicChats(client *clients.ChatClient) gin.HandlerFunc {
 				"member_count": ch.MembersCount,
 				"creator_name": ch.CreatorName,
 				"created_at":   ch.CreatedAt.AsTime(),
+
+				"join_approval": ch.JoinApproval,
 			})
 		}
 
@@ -509,7 +2055,8 @@ func PublicChats(client *clients.ChatClient) gin.HandlerFunc {
 func MarkChatRead(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req struct {
-			ChatId int64 `json:"chat_id"`
+			ChatId    int64 `json:"chat_id"`
+			MessageId int64 `json:"message_id"` // До какого сообщения прочитано, если не задано - до последнего
 		}
 
 		if err := c.BindJSON(&req); err != nil {
@@ -520,7 +2067,7 @@ func MarkChatRead(client *clients.ChatClient) gin.HandlerFunc {
 
 		ctx := utils.ContextWithToken(c)
 
-		_, err := client.Client.MarkChatRead(ctx, &chat_v1.MarkChatReadRequest{ChatId: req.ChatId})
+		_, err := client.Client.MarkChatRead(ctx, &chat_v1.MarkChatReadRequest{ChatId: req.ChatId, MessageId: req.MessageId})
 		if err != nil {
 			logger.Error("failed to mark chat read", zap.Error(err))
 			handleChatError(c, err)
@@ -531,6 +2078,48 @@ func MarkChatRead(client *clients.ChatClient) gin.HandlerFunc {
 	}
 }
 
+// GetReadReceipts возвращает участников, прочитавших сообщение
+func GetReadReceipts(client *clients.ChatClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		chatId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid chat id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid chat_id"})
+			return
+		}
+
+		messageId, err := strconv.ParseInt(c.Param("message_id"), 10, 64)
+		if err != nil {
+			logger.Warn("invalid message id", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message_id"})
+			return
+		}
+
+		ctx := utils.ContextWithToken(c)
+
+		resp, err := client.Client.GetReadReceipts(ctx, &chat_v1.GetReadReceiptsRequest{
+			ChatId:    chatId,
+			MessageId: messageId,
+		})
+		if err != nil {
+			logger.Error("failed to get read receipts", zap.Int64("chat_id", chatId), zap.Int64("message_id", messageId), zap.Error(err))
+			handleChatError(c, err)
+			return
+		}
+
+		receipts := make([]map[string]interface{}, 0, len(resp.Receipts))
+		for _, receipt := range resp.Receipts {
+			receipts = append(receipts, map[string]interface{}{
+				"user_id":  receipt.UserId,
+				"username": receipt.Username,
+				"read_at":  receipt.ReadAt.AsTime(),
+			})
+		}
+
+		c.JSON(http.StatusOK, gin.H{"receipts": receipts})
+	}
+}
+
 func UnreadCounts(client *clients.ChatClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := utils.ContextWithToken(c)
@@ -543,18 +2132,70 @@ func UnreadCounts(client *clients.ChatClient) gin.HandlerFunc {
 		}
 
 		counts := make(map[string]int32)
+		mentions := make(map[string]int32)
 		for _, count := range resp.UnreadCounts {
-			counts[strconv.FormatInt(count.ChatId, 10)] = count.Count
+			if count.Count > 0 {
+				counts[strconv.FormatInt(count.ChatId, 10)] = count.Count
+			}
+			if count.MentionCount > 0 {
+				mentions[strconv.FormatInt(count.ChatId, 10)] = count.MentionCount
+			}
+		}
+
+		// Непрочитанные в тредах по id корневого сообщения
+		threads := make(map[string]int32)
+		for _, count := range resp.ThreadUnreadCounts {
+			threads[strconv.FormatInt(count.ThreadRootId, 10)] = count.Count
 		}
 
 		c.JSON(http.StatusOK, gin.H{
-			"counts": counts,
-			"total":  resp.Total,
+			"counts":        counts,
+			"total":         resp.Total,
+			"threads":       threads,
+			"mentions":      mentions,
+			"mention_total": resp.MentionTotal,
 		})
 	}
 }
 
-func sendNotifications(client *clients.ChatClient, notificationHub *hub.Hub, ctx context.Context, sender *utils.UserClaims, chatId int64, text string, msgType int32, voiceDuration float32, fileUrl string, fileName string, fileSize int64) {
+// Повторы отправки сообщения: chat-server по client_key отдаст уже сохраненное сообщение, поэтому повтор безопасен
+const (
+	sendMessageAttempts       = 3
+	sendMessageAttemptTimeout = 5 * time.Second
+	sendMessageRetryDelay     = 200 * time.Millisecond
+)
+
+// sendMessageWithRetry отправляет сообщение в chat-server и повторяет попытку при таймауте или недоступности.
+// Если клиент не передал client_key, генерируем его сами, чтобы повтор не создал дубль.
+// retried == true, если ответ получен не с первой попытки: тогда дубль в ответе значит, что до нас дошла только первая попытка
+func sendMessageWithRetry(ctx context.Context, client *clients.ChatClient, req *chat_v1.SendMessageRequest) (resp *chat_v1.SendMessageResponse, retried bool, err error) {
+	if req.ClientKey == "" {
+		req.ClientKey = uuid.NewString()
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, sendMessageAttemptTimeout)
+		resp, err = client.Client.SendMessage(attemptCtx, req)
+		cancel()
+
+		code := status.Code(err)
+		if err == nil || attempt == sendMessageAttempts || (code != codes.Unavailable && code != codes.DeadlineExceeded) {
+			return resp, attempt > 1, err
+		}
+
+		logger.Warn("retrying send message", zap.Int64("chat_id", req.ChatId), zap.String("client_key", req.ClientKey), zap.Int("attempt", attempt), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil, true, ctx.Err()
+		case <-time.After(time.Duration(attempt) * sendMessageRetryDelay):
+		}
+	}
+}
+
+// sendNotifications рассылает уведомление о новом сообщении участникам чата.
+// Упомянутые участники получают отдельное уведомление типа mention, которое приходит даже в заглушенных чатах
+func sendNotifications(client *clients.ChatClient, notificationHub *hub.Hub, ctx context.Context, sender *utils.UserClaims, chatId int64, text string, msgType int32, voiceDuration float32, fileUrl string, fileName string, fileSize int64, mentionedIds []int64) {
 	if sender == nil {
 		return
 	}
@@ -604,13 +2245,15 @@ func sendNotifications(client *clients.ChatClient, notificationHub *hub.Hub, ctx
 		} else {
 			previewText = "📎 Файл"
 		}
+	case int32(chat_v1.MessageType_MESSAGE_TYPE_POLL):
+		messageType = "poll"
+		previewText = "📊 Опрос: " + text
 	}
 	if len(previewText) > 100 {
 		previewText = previewText[:100] + "…"
 	}
 
-	// Рассылаем
-	notificationHub.NotifyUsers(memberIds, sender.UserId, hub.Notification{
+	notification := hub.Notification{
 		Type:          "new_message",
 		ChatId:        chatId,
 		ChatName:      chatName,
@@ -622,11 +2265,36 @@ func sendNotifications(client *clients.ChatClient, notificationHub *hub.Hub, ctx
 		FileUrl:       fileUrl,
 		FileName:      fileName,
 		FileSize:      fileSize,
-	})
+	}
+
+	// Упомянутым уходит только уведомление об упоминании
+	mentioned := make(map[int64]struct{}, len(mentionedIds))
+	for _, id := range mentionedIds {
+		mentioned[id] = struct{}{}
+	}
+
+	recipients := make([]int64, 0, len(memberIds))
+	mentionRecipients := make([]int64, 0, len(mentionedIds))
+	for _, id := range memberIds {
+		if _, ok := mentioned[id]; ok {
+			mentionRecipients = append(mentionRecipients, id)
+			continue
+		}
+		recipients = append(recipients, id)
+	}
+
+	// Рассылаем
+	notificationHub.NotifyUsers(recipients, sender.UserId, notification)
+
+	if len(mentionRecipients) > 0 {
+		notification.Type = "mention"
+		notificationHub.NotifyUsers(mentionRecipients, sender.UserId, notification)
+	}
 
 	logger.Debug("notifications sent",
 		zap.Int64("chat_id", chatId),
 		zap.Int("recipients", len(memberIds)-1),
+		zap.Int("mentioned", len(mentionRecipients)),
 	)
 }
 
@@ -672,6 +2340,11 @@ func handleChatError(c *gin.Context, err error) {
 			"error": "Доступ запрещен",
 			"code":  "PERMISSION_DENIED",
 		})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": msg,
+			"code":  "FAILED_PRECONDITION",
+		})
 	default:
 		logger.Error("grpc error", zap.String("code", st.Code().String()), zap.String("msg", msg))
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -682,6 +2355,8 @@ func handleChatError(c *gin.Context, err error) {
 }
 
 func convertToWebSocketMessage(msg *chat_v1.Message) map[string]interface{} {
+	var res map[string]interface{}
+
 	switch msg.Type {
 	case chat_v1.MessageType_MESSAGE_TYPE_ONLINE_USERS:
 		onlineUsers := make([]map[string]interface{}, 0, len(msg.OnlineUsers))
@@ -698,8 +2373,7 @@ func convertToWebSocketMessage(msg *chat_v1.Message) map[string]interface{} {
 			"onlineCount": len(msg.OnlineUsers),
 		}
 	case chat_v1.MessageType_MESSAGE_TYPE_VOICE:
-
-		return map[string]interface{}{
+		res = map[string]interface{}{
 			"type":           "message",
 			"message_type":   "voice",
 			"from":           msg.From,
@@ -711,7 +2385,7 @@ func convertToWebSocketMessage(msg *chat_v1.Message) map[string]interface{} {
 			"file_size":      msg.FileSize,
 		}
 	case chat_v1.MessageType_MESSAGE_TYPE_IMAGE:
-		return map[string]interface{}{
+		res = map[string]interface{}{
 			"type":         "message",
 			"message_type": "image",
 			"from":         msg.From,
@@ -722,7 +2396,7 @@ func convertToWebSocketMessage(msg *chat_v1.Message) map[string]interface{} {
 			"file_size":    msg.FileSize,
 		}
 	case chat_v1.MessageType_MESSAGE_TYPE_FILE:
-		return map[string]interface{}{
+		res = map[string]interface{}{
 			"type":         "message",
 			"message_type": "file",
 			"from":         msg.From,
@@ -732,8 +2406,119 @@ func convertToWebSocketMessage(msg *chat_v1.Message) map[string]interface{} {
 			"file_name":    msg.FileName,
 			"file_size":    msg.FileSize,
 		}
-	default:
+	case chat_v1.MessageType_MESSAGE_TYPE_POLL:
+		res = map[string]interface{}{
+			"type":         "message",
+			"message_type": "poll",
+			"from":         msg.From,
+			"text":         msg.Text,
+			"sent_at":      msg.CreatedAt.AsTime(),
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_MEMBER_LEFT:
+		// Клиент убирает участника из списка, при смене владельца обновляет его
+		res = map[string]interface{}{
+			"type":    "member_left",
+			"sent_at": msg.CreatedAt.AsTime(),
+		}
+		if msg.MemberLeft != nil {
+			res["user_id"] = msg.MemberLeft.UserId
+			res["username"] = msg.MemberLeft.Username
+			if msg.MemberLeft.NewOwnerId != 0 {
+				res["new_owner_id"] = msg.MemberLeft.NewOwnerId
+			}
+		}
+		return res
+	case chat_v1.MessageType_MESSAGE_TYPE_CHAT_DELETED:
+		// Чат удален, сервер закроет соединение следом
 		return map[string]interface{}{
+			"type":       "chat_deleted",
+			"deleted_at": msg.CreatedAt.AsTime(),
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_LINK_PREVIEW:
+		// Клиент добавляет превью ссылки к сообщению с этим id
+		res = map[string]interface{}{
+			"type": "link_preview",
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_POLL_UPDATED:
+		// Клиент заменяет варианты и счетчики опроса с этим id
+		res = map[string]interface{}{
+			"type": "poll_updated",
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_EDITED:
+		// Клиент находит сообщение по id и заменяет текст
+		res = map[string]interface{}{
+			"type":    "message_edited",
+			"from":    msg.From,
+			"text":    msg.Text,
+			"sent_at": msg.CreatedAt.AsTime(),
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_TYPING:
+		res = map[string]interface{}{
+			"type": "typing",
+		}
+		if msg.Typing != nil {
+			res["user_id"] = msg.Typing.UserId
+			res["username"] = msg.Typing.Username
+			res["is_typing"] = msg.Typing.IsTyping
+			if msg.Typing.ExpiresAt != nil {
+				res["expires_at"] = msg.Typing.ExpiresAt.AsTime()
+			}
+		}
+		// Событие эфемерное, id у него нет
+		return res
+	case chat_v1.MessageType_MESSAGE_TYPE_READ_RECEIPT:
+		// Клиент отмечает прочитанными у этого участника все сообщения до id включительно
+		res = map[string]interface{}{
+			"type": "read_receipt",
+		}
+		if msg.ReadReceipt != nil {
+			res["user_id"] = msg.ReadReceipt.UserId
+			res["username"] = msg.ReadReceipt.Username
+			res["last_read_message_id"] = msg.ReadReceipt.LastReadMessageId
+			res["read_at"] = msg.ReadReceipt.ReadAt.AsTime()
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_PIN:
+		// Клиент обновляет плашку закрепа, в id закрепленное или открепленное сообщение
+		res = map[string]interface{}{
+			"type": "pin",
+		}
+		if msg.Pin != nil {
+			res["user_id"] = msg.Pin.UserId
+			res["username"] = msg.Pin.Username
+			res["pinned"] = msg.Pin.Pinned
+			if msg.Pin.Message != nil {
+				res["message"] = convertPreview(msg.Pin.Message)
+			}
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_REACTION:
+		// Клиент обновляет реакцию на сообщении с этим id
+		res = map[string]interface{}{
+			"type": "reaction",
+		}
+		if msg.Reaction != nil {
+			res["emoji"] = msg.Reaction.Emoji
+			res["user_id"] = msg.Reaction.UserId
+			res["username"] = msg.Reaction.Username
+			res["added"] = msg.Reaction.Added
+			res["count"] = msg.Reaction.Count
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_THREAD_UPDATED:
+		// Клиент обновляет счетчик ответов у корня треда с этим id
+		res = map[string]interface{}{
+			"type": "thread_updated",
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_DELETED:
+		// Клиент убирает сообщение с этим id
+		res = map[string]interface{}{
+			"type": "message_deleted",
+		}
+	case chat_v1.MessageType_MESSAGE_TYPE_EXPIRED:
+		// Клиент убирает исчезнувшее сообщение с этим id без заглушки
+		res = map[string]interface{}{
+			"type": "message_expired",
+		}
+	default:
+		res = map[string]interface{}{
 			"type":         "message",
 			"message_type": "text",
 			"from":         msg.From,
@@ -742,4 +2527,122 @@ func convertToWebSocketMessage(msg *chat_v1.Message) map[string]interface{} {
 		}
 	}
 
+	res["id"] = msg.Id
+	if msg.Seq > 0 {
+		res["seq"] = msg.Seq
+	}
+	if msg.Deleted {
+		res["deleted"] = true
+	}
+	if msg.ThreadRootId != 0 {
+		res["thread_root_id"] = msg.ThreadRootId
+	}
+	if msg.ThreadReplyCount > 0 {
+		res["thread_reply_count"] = msg.ThreadReplyCount
+	}
+	if msg.ThreadLastReplyAt != nil {
+		res["thread_last_reply_at"] = msg.ThreadLastReplyAt.AsTime()
+	}
+	if len(msg.Reactions) > 0 {
+		reactions := make([]map[string]interface{}, 0, len(msg.Reactions))
+		for _, r := range msg.Reactions {
+			reactions = append(reactions, map[string]interface{}{
+				"emoji":         r.Emoji,
+				"count":         r.Count,
+				"reacted_by_me": r.ReactedByMe,
+			})
+		}
+		res["reactions"] = reactions
+	}
+	if msg.ReplyTo != nil {
+		res["reply_to"] = convertPreview(msg.ReplyTo)
+	}
+	if len(msg.MentionedUserIds) > 0 {
+		res["mentioned_user_ids"] = msg.MentionedUserIds
+	}
+	if msg.ForwardedFrom != nil {
+		res["forwarded_from"] = map[string]interface{}{
+			"id":      msg.ForwardedFrom.MessageId,
+			"chat_id": msg.ForwardedFrom.ChatId,
+			"from":    msg.ForwardedFrom.From,
+		}
+	}
+	if msg.EditedAt != nil {
+		res["edited_at"] = msg.EditedAt.AsTime()
+	}
+	if msg.ExpiresAt != nil {
+		res["expires_at"] = msg.ExpiresAt.AsTime()
+	}
+	if msg.Poll != nil {
+		res["poll"] = convertPoll(msg.Poll)
+	}
+	if msg.LinkPreview != nil {
+		res["link_preview"] = map[string]interface{}{
+			"url":         msg.LinkPreview.Url,
+			"title":       msg.LinkPreview.Title,
+			"description": msg.LinkPreview.Description,
+			"image_url":   msg.LinkPreview.ImageUrl,
+			"site_name":   msg.LinkPreview.SiteName,
+		}
+	}
+
+	return res
+}
+
+// convertPoll опрос для клиента, voter_ids пустые в анонимном опросе
+func convertPoll(poll *chat_v1.Poll) map[string]interface{} {
+	options := make([]map[string]interface{}, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		voterIds := option.VoterIds
+		if voterIds == nil {
+			voterIds = []int64{}
+		}
+		options = append(options, map[string]interface{}{
+			"id":          option.Id,
+			"text":        option.Text,
+			"votes":       option.Votes,
+			"voted_by_me": option.VotedByMe,
+			"voter_ids":   voterIds,
+		})
+	}
+
+	res := map[string]interface{}{
+		"options":         options,
+		"multiple_choice": poll.MultipleChoice,
+		"anonymous":       poll.Anonymous,
+		"closed":          poll.Closed,
+		"total_voters":    poll.TotalVoters,
+	}
+	if poll.ClosesAt != nil {
+		res["closes_at"] = poll.ClosesAt.AsTime()
+	}
+
+	return res
+}
+
+// convertPreview превью сообщения (цитата, закреп) для клиента
+func convertPreview(preview *chat_v1.ReplyPreview) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           preview.MessageId,
+		"from":         preview.From,
+		"text":         preview.Text,
+		"message_type": messageTypeName(preview.Type),
+		"deleted":      preview.Deleted,
+	}
+}
+
+// messageTypeName название типа сообщения для клиента
+func messageTypeName(t chat_v1.MessageType) string {
+	switch t {
+	case chat_v1.MessageType_MESSAGE_TYPE_VOICE:
+		return "voice"
+	case chat_v1.MessageType_MESSAGE_TYPE_IMAGE:
+		return "image"
+	case chat_v1.MessageType_MESSAGE_TYPE_FILE:
+		return "file"
+	case chat_v1.MessageType_MESSAGE_TYPE_POLL:
+		return "poll"
+	default:
+		return "text"
+	}
 }
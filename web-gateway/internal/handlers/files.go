@@ -107,13 +107,14 @@ func SendFile(client *clients.ChatClient, storage storage.Storage, notificationH
 
 		// Отправляем
 		ctx := utils.ContextWithToken(c)
-		_, err = client.Client.SendMessage(ctx, &chat_v1.SendMessageRequest{
-			ChatId:   chatId,
-			Text:     text,
-			Type:     msgType,
-			FileUrl:  fileUrl,
-			FileName: header.Filename,
-			FileSize: header.Size,
+		resp, retried, err := sendMessageWithRetry(ctx, client, &chat_v1.SendMessageRequest{
+			ChatId:    chatId,
+			Text:      text,
+			Type:      msgType,
+			FileUrl:   fileUrl,
+			FileName:  header.Filename,
+			FileSize:  header.Size,
+			ClientKey: c.PostForm("client_key"),
 		})
 		if err != nil {
 			logger.Error("failed to send message", zap.Error(err))
@@ -126,10 +127,12 @@ func SendFile(client *clients.ChatClient, storage storage.Storage, notificationH
 		tokenStr, _ := token.(string)
 		senderClaims, _ := utils.ParseTokenClaims(tokenStr)
 
-		go func() {
-			ctx := utils.ContextWithToken(c)
-			sendNotifications(client, notificationHub, ctx, senderClaims, chatId, text, int32(msgType), 0, fileUrl, fileName, header.Size)
-		}()
+		if !resp.Duplicate || retried {
+			go func() {
+				ctx := utils.ContextWithToken(c)
+				sendNotifications(client, notificationHub, ctx, senderClaims, chatId, text, int32(msgType), 0, fileUrl, fileName, header.Size, resp.MentionedUserIds)
+			}()
+		}
 
 		c.JSON(http.StatusOK, gin.H{
 			"status":    "ok",
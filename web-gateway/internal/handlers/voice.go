@@ -63,7 +63,7 @@ func SendVoice(client *clients.ChatClient, storage storage.Storage, notification
 		ctx := utils.ContextWithToken(c)
 
 		// Сохраняем сообщение в БД
-		_, err = client.Client.SendMessage(ctx, &chat_v1.SendMessageRequest{
+		resp, retried, err := sendMessageWithRetry(ctx, client, &chat_v1.SendMessageRequest{
 			ChatId:        chatId,
 			Text:          text,
 			Type:          chat_v1.MessageType_MESSAGE_TYPE_VOICE,
@@ -71,6 +71,7 @@ func SendVoice(client *clients.ChatClient, storage storage.Storage, notification
 			FileUrl:       voiceURL,
 			FileName:      header.Filename,
 			FileSize:      header.Size,
+			ClientKey:     c.PostForm("client_key"),
 		})
 		if err != nil {
 			logger.Error("failed to send message", zap.Error(err))
@@ -83,10 +84,12 @@ func SendVoice(client *clients.ChatClient, storage storage.Storage, notification
 		tokenStr, _ := token.(string)
 		senderClaims, _ := utils.ParseTokenClaims(tokenStr)
 
-		go func() {
-			ctx := utils.ContextWithToken(c)
-			sendNotifications(client, notificationHub, ctx, senderClaims, chatId, text, int32(chat_v1.MessageType_MESSAGE_TYPE_VOICE), float32(duration), voiceURL, header.Filename, header.Size)
-		}()
+		if !resp.Duplicate || retried {
+			go func() {
+				ctx := utils.ContextWithToken(c)
+				sendNotifications(client, notificationHub, ctx, senderClaims, chatId, text, int32(chat_v1.MessageType_MESSAGE_TYPE_VOICE), float32(duration), voiceURL, header.Filename, header.Size, resp.MentionedUserIds)
+			}()
+		}
 
 		c.JSON(http.StatusOK, gin.H{
 			"status":    "sent",
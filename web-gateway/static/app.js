@@ -432,7 +432,8 @@ document.addEventListener('DOMContentLoaded', () => {
                     body: JSON.stringify({
                         name: name,
                         usernames: usernames,
-                        is_public: chatType === 'public'
+                        is_public: chatType !== 'private',
+                        join_approval: chatType === 'approval'
                     })
                 });
 
@@ -508,8 +509,8 @@ async function loadPublicChats(search = '') {
                         <div class="chat-card__meta">${formatChatDate(chat.created_at)}</div>
                     </div>
                     <button onclick="joinPublicChat(${chat.id}, '${escapeHtml(chat.name)}')" class="btn-join">
-                        <i class="fas fa-sign-in-alt"></i>
-                        Войти
+                        <i class="fas ${chat.join_approval ? 'fa-user-check' : 'fa-sign-in-alt'}"></i>
+                        ${chat.join_approval ? 'Подать заявку' : 'Войти'}
                     </button>
                 </div>
             `;
@@ -537,6 +538,11 @@ async function joinPublicChat(chatId, chatName) {
             return;
         }
 
+        if (data.pending) {
+            showToast({ type: 'success', title: 'Заявка отправлена', message: `${chatName}: дождитесь одобрения администратора` });
+            return;
+        }
+
         showToast({ type: 'success', title: 'Вы присоединились!', message: chatName });
         loadPublicChats();
         loadChatCount();
@@ -842,6 +848,25 @@ function connectToChat(chatId) {
 
         if (msg.type === 'online_users') {
             updateChatOnlineUsers(msg);
+        } else if (msg.type === 'chat_deleted') {
+            // Сервер следом закроет соединение, чат пропадает из списка
+            if (activeChatId === chatId) {
+                closeChatInfo();
+                closeActiveChat();
+                showToast({ type: 'warning', title: 'Чат удалён', message: 'Этот чат больше недоступен' });
+            }
+            loadChatList();
+            loadChatCount();
+        } else if (msg.type === 'member_left') {
+            // Вышли мы сами (например, с другого устройства) - закрываем чат, иначе обновляем участников
+            if (String(msg.user_id) === String(TokenManager.getUserId())) {
+                if (activeChatId === chatId) {
+                    closeChatInfo();
+                    closeActiveChat();
+                }
+                loadChatCount();
+            }
+            loadChatList();
         } else {
             displayChatMessage(msg);
 
@@ -874,6 +899,29 @@ function connectToChat(chatId) {
     };
 }
 
+// closeActiveChat отключается от открытого чата и показывает пустой экран
+function closeActiveChat() {
+    if (activeChatId) {
+        NotificationManager.clearActiveChat();
+    }
+
+    if (activeChatWs) {
+        // Обработчики снимаем, чтобы закрытие не перезаписало статус уже другого чата
+        activeChatWs.onclose = null;
+        activeChatWs.onmessage = null;
+        activeChatWs.close();
+        activeChatWs = null;
+    }
+    activeChatId = null;
+
+    document.getElementById('chatViewActive').style.display = 'none';
+    document.getElementById('chatViewEmpty').style.display = '';
+
+    document.querySelectorAll('.chat-list-item.active').forEach(function(item) {
+        item.classList.remove('active');
+    });
+}
+
 // ========== Сообщения ==========
 
 async function sendActiveMessage() {
@@ -3594,6 +3642,8 @@ function renderChatInfo(chat) {
     const typeEl = document.getElementById('chatInfoType');
     if (isDirect) {
         typeEl.innerHTML = '<i class="fas fa-user"></i><span>Личный чат</span>';
+    } else if (isPublic && chat.join_approval) {
+        typeEl.innerHTML = '<i class="fas fa-user-check"></i><span>Открытый чат, вступление по заявке</span>';
     } else if (isPublic) {
         typeEl.innerHTML = '<i class="fas fa-globe"></i><span>Открытый чат</span>';
     } else {
@@ -3664,6 +3714,15 @@ function renderChatInfo(chat) {
 
     membersContainer.innerHTML = membersHtml;
 
+    // Заявки рассматривают роли с правом добавлять участников
+    const myRole = (chat.member_roles || {})[myId];
+    const joinRequestsSection = document.getElementById('chatInfoJoinRequestsSection');
+    if (joinRequestsSection) {
+        const canReview = chat.join_approval && ['owner', 'admin', 'moderator'].includes(myRole);
+        joinRequestsSection.style.display = 'none';
+        if (canReview) loadJoinRequests(chat.id);
+    }
+
     // Кнопка удаления чата
     const deleteBtn = document.getElementById('chatInfoDeleteBtn');
     if (deleteBtn) {
@@ -3684,6 +3743,66 @@ document.addEventListener('keydown', (e) => {
 
 // ========== Действия ==========
 
+async function loadJoinRequests(chatId) {
+    const section = document.getElementById('chatInfoJoinRequestsSection');
+    const container = document.getElementById('chatInfoJoinRequests');
+    if (!section || !container) return;
+
+    try {
+        const response = await apiRequest(`/api/chat/${chatId}/join-requests`);
+        const data = await response.json();
+        if (!response.ok || String(activeChatId) !== String(chatId)) return;
+
+        const requests = data.requests || [];
+        document.getElementById('chatInfoJoinRequestsCount').textContent = requests.length;
+        section.style.display = requests.length > 0 ? 'block' : 'none';
+
+        container.innerHTML = requests.map(r =>
+            '<div class="chat-info-member">' +
+                '<div class="chat-info-member__avatar">' + avatarHtml('', r.username, 36) + '</div>' +
+                '<div class="chat-info-member__body">' +
+                    '<div class="chat-info-member__name">' + escapeHtml(r.username) + '</div>' +
+                    '<span class="chat-info-member__role">' + formatChatDate(r.created_at) + '</span>' +
+                '</div>' +
+                '<button class="btn-icon-sm" onclick="resolveJoinRequest(' + chatId + ', ' + r.id + ', true)" title="Одобрить">' +
+                    '<i class="fas fa-check"></i>' +
+                '</button>' +
+                '<button class="btn-icon-sm" onclick="resolveJoinRequest(' + chatId + ', ' + r.id + ', false)" title="Отклонить">' +
+                    '<i class="fas fa-times"></i>' +
+                '</button>' +
+            '</div>'
+        ).join('');
+    } catch (error) {
+        console.error('Failed to load join requests:', error);
+    }
+}
+
+async function resolveJoinRequest(chatId, requestId, approve) {
+    try {
+        const response = await apiRequest(`/api/chat/join-requests/${requestId}/${approve ? 'approve' : 'reject'}`, {
+            method: 'POST'
+        });
+        const data = await response.json();
+
+        if (!response.ok) {
+            showToast({ type: 'error', title: 'Ошибка', message: data.error });
+            loadJoinRequests(chatId);
+            return;
+        }
+
+        if (approve) {
+            showToast({ type: 'success', title: 'Заявка одобрена', message: 'Пользователь добавлен в чат' });
+        }
+
+        // Обновляем список чатов и панель, панель сама перезагрузит заявки
+        await loadChatList();
+        const updatedChat = chatListData.find(c => c.id === activeChatId);
+        if (updatedChat) renderChatInfo(updatedChat);
+    } catch (error) {
+        showToast({ type: 'error', title: 'Ошибка', message: error.message });
+    }
+}
+
 async function addMemberFromInput() {
     const input = document.getElementById('addMemberInput');
     if (!input) return;
@@ -3766,15 +3885,19 @@ async function leaveOrDeleteChat() {
 
     const chat = chatListData.find(c => c.id === activeChatId);
     const currentUserId = TokenManager.getUserId();
-    const isOwner = chat && chat.creator_id === parseInt(currentUserId);
+    // Личный чат любой из собеседников удаляет, из группового выходят все, кроме владельца
+    const isOwner = chat && (chat.is_direct || chat.creator_id === parseInt(currentUserId));
 
     const action = isOwner ? 'удалить' : 'покинуть';
     if (!confirm(`Вы уверены, что хотите ${action} этот чат?`)) return;
 
     try {
-        const response = await apiRequest(`/api/chat/delete/${activeChatId}`, {
-            method: 'DELETE'
-        });
+        const response = isOwner
+            ? await apiRequest(`/api/chat/delete/${activeChatId}`, { method: 'DELETE' })
+            : await apiRequest('/api/chat/leave', {
+                method: 'POST',
+                body: JSON.stringify({ chat_id: activeChatId })
+            });
 
         if (response.ok) {
             showToast({
@@ -4055,8 +4178,16 @@ const NotificationManager = {
         console.log('🔔 WS message received:', data);
         switch (data.type) {
             case 'new_message':
+            case 'mention': // Упоминание приходит отдельным типом, даже если чат заглушен
                 this.handleNewMessage(data);
                 break;
+            case 'join_request_approved':
+                showToast({ type: 'success', title: data.text, message: data.chat_name });
+                loadChatCount();
+                break;
+            case 'join_request_rejected':
+                showToast({ type: 'error', title: data.text, message: data.chat_name });
+                break;
             case 'send-file': // Добавляем обработку ответа на отправку файла
             case 'file_sent':
                 this.handleFileMessage(data);
@@ -4868,6 +4999,53 @@ document.addEventListener('keydown', function(e) {
     }
 });
 
+// ==================== INVITE LINKS ====================
+// Ссылка /invite/<code> перенаправляет сюда с параметром invite
+async function handleInviteLink() {
+    const params = new URLSearchParams(window.location.search);
+    const code = params.get('invite');
+    if (!code) return;
+
+    if (!TokenManager.isAuthenticated()) {
+        showToast({ type: 'error', title: 'Приглашение', message: 'Войдите, чтобы вступить в чат, и откройте ссылку снова' });
+        return;
+    }
+
+    // Убираем код из адресной строки, чтобы не вступать повторно при обновлении
+    params.delete('invite');
+    const query = params.toString();
+    window.history.replaceState(null, '', window.location.pathname + (query ? '?' + query : ''));
+
+    try {
+        const encoded = encodeURIComponent(code);
+        const previewResp = await apiRequest(`/api/invite/${encoded}`);
+        const preview = await previewResp.json();
+        if (!previewResp.ok) {
+            showToast({ type: 'error', title: 'Приглашение', message: preview.error || 'Приглашение недействительно' });
+            return;
+        }
+
+        if (preview.is_member) {
+            showToast({ type: 'success', title: 'Приглашение', message: `Вы уже участник чата "${preview.name}"` });
+            return;
+        }
+
+        if (!confirm(`Вступить в чат "${preview.name}"? Участников: ${preview.member_count}`)) return;
+
+        const joinResp = await apiRequest(`/api/invite/${encoded}/join`, { method: 'POST' });
+        const data = await joinResp.json();
+        if (!joinResp.ok) {
+            showToast({ type: 'error', title: 'Приглашение', message: data.error || 'Не удалось вступить в чат' });
+            return;
+        }
+
+        showToast({ type: 'success', title: 'Приглашение', message: `Вы вступили в чат "${preview.name}"` });
+        loadChatCount();
+    } catch (error) {
+        showToast({ type: 'error', title: 'Приглашение', message: error.message });
+    }
+}
+
 // ==================== INITIALIZATION ====================
 document.addEventListener('DOMContentLoaded', () => {
     console.log('🚀 App initializing...');
@@ -4884,5 +5062,6 @@ document.addEventListener('DOMContentLoaded', () => {
             startChatCountUpdater();
         }, 100);
     }
+    handleInviteLink();
     console.log('✅ App initialized');
 });
\ No newline at end of file